@@ -0,0 +1,65 @@
+package scorumgo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/scorum/scorum-go/key"
+)
+
+type KeyRole string
+
+const (
+	OwnerKeyRole   KeyRole = "owner"
+	ActiveKeyRole  KeyRole = "active"
+	PostingKeyRole KeyRole = "posting"
+	MemoKeyRole    KeyRole = "memo"
+)
+
+// AccountKeyRoles describes the roles the key holds in the account.
+type AccountKeyRoles struct {
+	Account string
+	Roles   []KeyRole
+}
+
+// AccountsForPrivateKey finds the accounts controlled by the private key
+// and reports which roles its public key holds in each of them.
+func (client *Client) AccountsForPrivateKey(ctx context.Context, priv *key.PrivateKey) ([]AccountKeyRoles, error) {
+	pub := priv.PublicKey()
+
+	refs, err := client.AccountByKey.GetKeyReferences(ctx, pub)
+	if err != nil {
+		return nil, fmt.Errorf("get key references: %w", err)
+	}
+
+	if len(refs) == 0 || len(refs[0]) == 0 {
+		return nil, nil
+	}
+
+	accounts, err := client.Database.GetAccounts(ctx, refs[0]...)
+	if err != nil {
+		return nil, fmt.Errorf("get accounts: %w", err)
+	}
+
+	out := make([]AccountKeyRoles, 0, len(accounts))
+	for _, account := range accounts {
+		roles := AccountKeyRoles{Account: account.Name}
+
+		if authorityHasKey(account.Owner, pub) {
+			roles.Roles = append(roles.Roles, OwnerKeyRole)
+		}
+		if authorityHasKey(account.Active, pub) {
+			roles.Roles = append(roles.Roles, ActiveKeyRole)
+		}
+		if authorityHasKey(account.Posting, pub) {
+			roles.Roles = append(roles.Roles, PostingKeyRole)
+		}
+		if account.MemoKey != nil && account.MemoKey.String() == pub.String() {
+			roles.Roles = append(roles.Roles, MemoKeyRole)
+		}
+
+		out = append(out, roles)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,30 @@
+package account_by_key
+
+import (
+	"context"
+
+	"github.com/scorum/scorum-go/caller"
+	"github.com/scorum/scorum-go/key"
+)
+
+const APIID = "account_by_key_api"
+
+type API struct {
+	caller caller.Caller
+}
+
+func NewAPI(caller caller.Caller) *API {
+	return &API{caller}
+}
+
+func (api *API) call(ctx context.Context, method string, args []interface{}, reply interface{}) error {
+	return api.caller.Call(ctx, APIID, method, args, reply)
+}
+
+// GetKeyReferences returns names of the accounts which authorities refer the keys,
+// the result contains account names for every key in the same order the keys are passed
+func (api *API) GetKeyReferences(ctx context.Context, keys ...*key.PublicKey) ([][]string, error) {
+	var resp [][]string
+	err := api.call(ctx, "get_key_references", []interface{}{keys}, &resp)
+	return resp, err
+}
@@ -0,0 +1,26 @@
+package account_by_key
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/scorum/scorum-go/key"
+	"github.com/scorum/scorum-go/rpc"
+)
+
+const nodeHTTPS = "https://testnet.scorum.work"
+
+func TestGetKeyReferences(t *testing.T) {
+	transport := rpc.NewHTTPTransport(nodeHTTPS)
+	api := NewAPI(transport)
+
+	pub, err := key.NewPublicKey("SCR7cTf2Dx9rxffs6E2z2pdn5cLMneo3AAFSsF9g4SaVviCYdfQ63")
+	require.NoError(t, err)
+
+	refs, err := api.GetKeyReferences(context.Background(), pub)
+	require.NoError(t, err)
+	require.Len(t, refs, 1)
+	require.Contains(t, refs[0], "azucena")
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/scorum/scorum-go/caller"
+	"github.com/scorum/scorum-go/types"
 )
 
 const APIID = "account_history_api"
@@ -26,16 +27,51 @@ func (api *API) call(ctx context.Context, method string, args []interface{}, rep
 // from - the absolute sequence number, -1 means most recent, limit is the number of operations before from.
 // limit - the maximum number of items that can be queried [1 to 1000], must be less than from
 func (api *API) GetAccountHistory(ctx context.Context, account string, from, limit int32) (AccountHistory, error) {
-	resp := make(AccountHistory, 0)
-	err := api.call(ctx, "get_account_history", []interface{}{account, from, limit}, &resp)
-	return resp, err
+	return api.Query(ctx, HistoryQuery{Account: account, From: from, Limit: limit})
 }
 
 // GetAccountScrToScrTransfers returns transactions history for the given account
 // from - the absolute sequence number, -1 means most recent, limit is the number of operations before from.
 // limit - the maximum number of items that can be queried [1 to 1000], must be less than from
 func (api *API) GetAccountScrToScrTransfers(ctx context.Context, account string, from, limit int32) (AccountHistory, error) {
+	return api.Query(ctx, HistoryQuery{Account: account, From: from, Limit: limit, Filter: ScrToScrTransfers})
+}
+
+// GetAccountScrToSpTransfers returns transfer_to_scorumpower history for the given account
+// from - the absolute sequence number, -1 means most recent, limit is the number of operations before from.
+// limit - the maximum number of items that can be queried [1 to 1000], must be less than from
+func (api *API) GetAccountScrToSpTransfers(ctx context.Context, account string, from, limit int32) (AccountHistory, error) {
+	return api.Query(ctx, HistoryQuery{Account: account, From: from, Limit: limit, Filter: ScrToSpTransfers})
+}
+
+// GetAccountSpToScrTransfers returns scorumpower withdrawals history for the given account
+// from - the absolute sequence number, -1 means most recent, limit is the number of operations before from.
+// limit - the maximum number of items that can be queried [1 to 1000], must be less than from
+func (api *API) GetAccountSpToScrTransfers(ctx context.Context, account string, from, limit int32) (AccountHistory, error) {
+	return api.Query(ctx, HistoryQuery{Account: account, From: from, Limit: limit, Filter: SpToScrTransfers})
+}
+
+// GetAccountHistoryByOpTypes returns operations of the given types only.
+// The node has no index by operation type, so the filter is applied to the [from-limit, from] range
+// and the result may contain less than limit items.
+func (api *API) GetAccountHistoryByOpTypes(ctx context.Context, account string, from, limit int32, opTypes ...types.OpType) (AccountHistory, error) {
+	history, err := api.GetAccountHistory(ctx, account, from, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return history.Filter(opTypes...), nil
+}
+
+// Query returns the account history from the node-side index selected by the query filter,
+// the whole history is queried if the filter is empty
+func (api *API) Query(ctx context.Context, query HistoryQuery) (AccountHistory, error) {
+	filter := query.Filter
+	if filter == "" {
+		filter = AllHistory
+	}
+
 	resp := make(AccountHistory, 0)
-	err := api.call(ctx, "get_account_scr_to_scr_transfers", []interface{}{account, from, limit}, &resp)
+	err := api.call(ctx, string(filter), []interface{}{query.Account, query.From, query.Limit}, &resp)
 	return resp, err
 }
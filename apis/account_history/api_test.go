@@ -19,3 +19,11 @@ func TestGetAccountScrToScrTransfers(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, len(history) > 0)
 }
+
+func TestGetAccountScrToSpTransfers(t *testing.T) {
+	transport := rpc.NewHTTPTransport(nodeHTTPS)
+	api := NewAPI(transport)
+
+	_, err := api.GetAccountScrToSpTransfers(context.Background(), "sheldon", -1, 3)
+	require.NoError(t, err)
+}
@@ -7,8 +7,51 @@ import (
 	"github.com/scorum/scorum-go/types"
 )
 
+// HistoryFilter is the node-side history index, it matches the name of the api method which serves it.
+type HistoryFilter string
+
+const (
+	AllHistory        HistoryFilter = "get_account_history"
+	ScrToScrTransfers HistoryFilter = "get_account_scr_to_scr_transfers"
+	ScrToSpTransfers  HistoryFilter = "get_account_scr_to_sp_transfers"
+	SpToScrTransfers  HistoryFilter = "get_account_sp_to_scr_transfers"
+)
+
+// HistoryQuery selects the range [From-Limit, From] of the account history index.
+type HistoryQuery struct {
+	Account string
+	// From is the absolute sequence number, -1 means most recent
+	From int32
+	// Limit is the number of operations before From [1 to 1000]
+	Limit  int32
+	Filter HistoryFilter
+}
+
 type AccountHistory map[uint32]*types.OperationObject
 
+// Filter returns the history items which contain an operation of any of the given types.
+func (ah AccountHistory) Filter(opTypes ...types.OpType) AccountHistory {
+	out := make(AccountHistory)
+	for seq, obj := range ah {
+		for _, op := range obj.Operations {
+			if containsOpType(opTypes, op.Type()) {
+				out[seq] = obj
+				break
+			}
+		}
+	}
+	return out
+}
+
+func containsOpType(opTypes []types.OpType, opType types.OpType) bool {
+	for _, t := range opTypes {
+		if t == opType {
+			return true
+		}
+	}
+	return false
+}
+
 func (ah *AccountHistory) UnmarshalJSON(b []byte) (err error) {
 	// unmarshal array
 	var o []json.RawMessage
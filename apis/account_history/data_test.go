@@ -0,0 +1,27 @@
+package account_history
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/scorum/scorum-go/types"
+)
+
+func TestAccountHistory_Filter(t *testing.T) {
+	data := `[
+		[1, {"block": 10, "op": ["transfer", {"from": "alice", "to": "bob", "amount": "1.000000000 SCR", "memo": ""}]}],
+		[2, {"block": 11, "op": ["vote", {"voter": "alice", "author": "bob", "permlink": "p", "weight": 100}]}]
+	]`
+
+	history := make(AccountHistory)
+	require.NoError(t, json.Unmarshal([]byte(data), &history))
+	require.Len(t, history, 2)
+
+	transfers := history.Filter(types.TransferOpType)
+	require.Len(t, transfers, 1)
+	require.Contains(t, transfers, uint32(1))
+
+	require.Empty(t, history.Filter(types.CommentOpType))
+}
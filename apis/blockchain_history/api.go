@@ -64,3 +64,10 @@ func (api *API) GetBlocks(ctx context.Context, blockNum uint32, limit uint32) (B
 	err := api.call(ctx, "get_blocks", []interface{}{blockNum, limit}, &resp)
 	return resp, err
 }
+
+// Get a signed transaction by the given transaction id along with the block it is included into
+func (api *API) GetTransaction(ctx context.Context, trxID string) (*TransactionInfo, error) {
+	var resp TransactionInfo
+	err := api.call(ctx, "get_transaction", []interface{}{trxID}, &resp)
+	return &resp, err
+}
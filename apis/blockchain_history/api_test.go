@@ -96,3 +96,14 @@ func TestGetBlocks(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestGetTransaction(t *testing.T) {
+	transport := rpc.NewHTTPTransport(nodeHTTPS)
+	api := NewAPI(transport)
+
+	trx, err := api.GetTransaction(context.Background(), "825d2733272648f95c21ae97be9f8c3d8edaf8f9")
+	require.NoError(t, err)
+	require.EqualValues(t, 31449514, trx.BlockNum)
+	require.Len(t, trx.Operations, 1)
+	require.Len(t, trx.Signatures, 1)
+}
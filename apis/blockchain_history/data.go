@@ -113,3 +113,10 @@ func (bs *Blocks) UnmarshalJSON(b []byte) (err error) {
 
 	return nil
 }
+
+type TransactionInfo struct {
+	types.Transaction
+	TransactionID  string `json:"transaction_id"`
+	BlockNum       uint32 `json:"block_num"`
+	TransactionNum uint32 `json:"transaction_num"`
+}
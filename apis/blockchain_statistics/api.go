@@ -0,0 +1,43 @@
+package blockchain_statistics
+
+import (
+	"context"
+
+	"github.com/scorum/scorum-go/caller"
+	"github.com/scorum/scorum-go/types"
+)
+
+const APIID = "blockchain_statistics_api"
+
+type API struct {
+	caller caller.Caller
+}
+
+func NewAPI(caller caller.Caller) *API {
+	return &API{caller}
+}
+
+func (api *API) call(ctx context.Context, method string, args []interface{}, reply interface{}) error {
+	return api.caller.Call(ctx, APIID, method, args, reply)
+}
+
+// GetStatsForTime returns statistics for the bucket of interval seconds which opens at the given time
+func (api *API) GetStatsForTime(ctx context.Context, open types.Time, interval uint32) (*Statistics, error) {
+	var resp Statistics
+	err := api.call(ctx, "get_stats_for_time", []interface{}{&open, interval}, &resp)
+	return &resp, err
+}
+
+// GetStatsForInterval returns statistics aggregated over the [start, end] time interval
+func (api *API) GetStatsForInterval(ctx context.Context, start, end types.Time) (*Statistics, error) {
+	var resp Statistics
+	err := api.call(ctx, "get_stats_for_interval", []interface{}{&start, &end}, &resp)
+	return &resp, err
+}
+
+// GetLifetimeStats returns statistics over the whole chain lifetime
+func (api *API) GetLifetimeStats(ctx context.Context) (*Statistics, error) {
+	var resp Statistics
+	err := api.call(ctx, "get_lifetime_stats", caller.EmptyParams, &resp)
+	return &resp, err
+}
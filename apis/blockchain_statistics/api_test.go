@@ -0,0 +1,22 @@
+package blockchain_statistics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/scorum/scorum-go/rpc"
+)
+
+const nodeHTTPS = "https://testnet.scorum.work"
+
+func TestGetLifetimeStats(t *testing.T) {
+	transport := rpc.NewHTTPTransport(nodeHTTPS)
+	api := NewAPI(transport)
+
+	stats, err := api.GetLifetimeStats(context.Background())
+	require.NoError(t, err)
+	require.True(t, stats.Blocks > 0)
+	require.True(t, stats.Transactions > 0)
+}
@@ -0,0 +1,44 @@
+package blockchain_statistics
+
+import "github.com/scorum/scorum-go/types"
+
+type Statistics struct {
+	ID                                    uint32      `json:"id"`
+	Blocks                                uint32      `json:"blocks"`
+	Bandwidth                             uint64      `json:"bandwidth"`
+	Operations                            uint32      `json:"operations"`
+	Transactions                          uint32      `json:"transactions"`
+	Transfers                             uint32      `json:"transfers"`
+	TransfersAmount                       types.Asset `json:"transfers_amount"`
+	TransfersToScorumpower                uint32      `json:"transfers_to_scorumpower"`
+	TransfersToScorumpowerAmount          types.Asset `json:"transfers_to_scorumpower_amount"`
+	Accounts                              uint32      `json:"accounts"`
+	AccountsCreated                       uint32      `json:"accounts_created"`
+	AccountsCreatedWithFee                uint32      `json:"accounts_created_with_fee"`
+	AccountsCreatedByWitness              uint32      `json:"accounts_created_by_witness"`
+	AccountsCreatedWithDelegation         uint32      `json:"accounts_created_with_delegation"`
+	Posts                                 uint32      `json:"posts"`
+	Comments                              uint32      `json:"comments"`
+	RootComments                          uint32      `json:"root_comments"`
+	RootCommentsDeleted                   uint32      `json:"root_comments_deleted"`
+	CommentsDeleted                       uint32      `json:"comments_deleted"`
+	Votes                                 uint32      `json:"votes"`
+	NewVotes                              uint32      `json:"new_votes"`
+	ChangedVotes                          uint32      `json:"changed_votes"`
+	PaidOutComments                       uint32      `json:"paid_out_comments"`
+	ScorumpowerWithdrawalsProcessed       uint32      `json:"scorumpower_withdrawals_processed"`
+	FinishedScorumpowerWithdrawals        uint32      `json:"finished_scorumpower_withdrawals"`
+	ScorumpowerWithdrawn                  types.Asset `json:"scorumpower_withdrawn"`
+	ScorumpowerTransferred                types.Asset `json:"scorumpower_transferred"`
+	NewScorumpowerWithdrawalRequests      uint32      `json:"new_scorumpower_withdrawal_requests"`
+	ModifiedScorumpowerWithdrawalRequests uint32      `json:"modified_scorumpower_withdrawal_requests"`
+	TotalScorumpowerWithdrawalRequests    uint32      `json:"total_scorumpower_withdrawal_requests"`
+	ScorumpowerDelegations                uint32      `json:"scorumpower_delegations"`
+	ScorumpowerDelegationsAmount          types.Asset `json:"scorumpower_delegations_amount"`
+	ActiveSPHoldersRewardScr              types.Asset `json:"active_sp_holders_reward_scr"`
+	ActiveSPHoldersRewardSP               types.Asset `json:"active_sp_holders_reward_sp"`
+	AuthorRewardScr                       types.Asset `json:"author_reward_scr"`
+	AuthorRewardSP                        types.Asset `json:"author_reward_sp"`
+	CurationRewardScr                     types.Asset `json:"curation_reward_scr"`
+	CurationRewardSP                      types.Asset `json:"curation_reward_sp"`
+}
@@ -21,3 +21,10 @@ func (api *API) GetChainProperties(ctx context.Context) (*ChainProperties, error
 	err := api.caller.Call(ctx, APIID, "get_chain_properties", caller.EmptyParams, &resp)
 	return &resp, err
 }
+
+// GetChainCapital returns the current distribution of the chain capital between accounts, funds and pools
+func (api *API) GetChainCapital(ctx context.Context) (*ChainCapital, error) {
+	var resp ChainCapital
+	err := api.caller.Call(ctx, APIID, "get_chain_capital", caller.EmptyParams, &resp)
+	return &resp, err
+}
@@ -21,3 +21,13 @@ func TestGetChainProperties(t *testing.T) {
 	require.True(t, props.HeadBlockNumber > 0)
 	require.True(t, props.LastIrreversibleBlockNumber > 0)
 }
+
+func TestGetChainCapital(t *testing.T) {
+	transport := rpc.NewHTTPTransport(nodeHTTPS)
+	api := NewAPI(transport)
+
+	capital, err := api.GetChainCapital(context.Background())
+	require.NoError(t, err)
+	require.True(t, capital.HeadBlockNumber > 0)
+	require.False(t, capital.Time.IsZero())
+}
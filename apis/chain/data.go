@@ -22,3 +22,25 @@ type MediaChainProperties struct {
 	AccountCreationFee types.Asset `json:"account_creation_fee"`
 	MaximumBlockSize   uint32      `json:"maximum_block_size"`
 }
+
+type ChainCapital struct {
+	HeadBlockNumber                            uint32      `json:"head_block_number"`
+	HeadBlockID                                string      `json:"head_block_id"`
+	Time                                       types.Time  `json:"time"`
+	CurrentWitness                             string      `json:"current_witness"`
+	TotalSupply                                types.Asset `json:"total_supply"`
+	CirculatingCapital                         types.Asset `json:"circulating_capital"`
+	TotalScr                                   types.Asset `json:"total_scr"`
+	TotalScorumpower                           types.Asset `json:"total_scorumpower"`
+	RegistrationPoolBalance                    types.Asset `json:"registration_pool_balance"`
+	FundBudgetBalance                          types.Asset `json:"fund_budget_balance"`
+	RewardPoolBalance                          types.Asset `json:"reward_pool_balance"`
+	MaxAllowedRewardPoolBalance                types.Asset `json:"max_allowed_reward_pool_balance"`
+	ContentBalancerScr                         types.Asset `json:"content_balancer_scr"`
+	ActiveVotersBalancerScr                    types.Asset `json:"active_voters_balancer_scr"`
+	ActiveVotersBalancerSP                     types.Asset `json:"active_voters_balancer_sp"`
+	ContentRewardFundScrBalance                types.Asset `json:"content_reward_fund_scr_balance"`
+	ContentRewardFundSPBalance                 types.Asset `json:"content_reward_fund_sp_balance"`
+	ContentRewardFifaWorldCup2018BountyBalance types.Asset `json:"content_reward_fifa_world_cup_2018_bounty_balance"`
+	WitnessRewardInSPMigrationFundBalance      types.Asset `json:"witness_reward_in_sp_migration_fund_balance"`
+}
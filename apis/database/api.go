@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 
 	"github.com/scorum/scorum-go/caller"
+	"github.com/scorum/scorum-go/key"
 	"github.com/scorum/scorum-go/types"
 )
 
@@ -61,6 +62,146 @@ func (api *API) LookupAccounts(ctx context.Context, lowerBoundName string, limit
 	return resp, err
 }
 
+// GetAtomicSwapContracts returns all atomic swap contracts owned by the given account
+func (api *API) GetAtomicSwapContracts(ctx context.Context, owner string) ([]*AtomicSwapContract, error) {
+	var resp []*AtomicSwapContract
+	err := api.call(ctx, "get_atomicswap_contracts", []interface{}{owner}, &resp)
+	return resp, err
+}
+
+// GetAtomicSwapContract returns the contract from -> to locked with the given secret hash.
+// Secret is filled in as soon as the contract is redeemed.
+func (api *API) GetAtomicSwapContract(ctx context.Context, from, to, secretHash string) (*AtomicSwapContractInfo, error) {
+	var resp AtomicSwapContractInfo
+	err := api.call(ctx, "get_atomicswap_contract", []interface{}{from, to, secretHash}, &resp)
+	return &resp, err
+}
+
+// GetEscrow returns the escrow created by the sender with the given escrow id
+func (api *API) GetEscrow(ctx context.Context, from string, escrowID uint32) (*Escrow, error) {
+	var resp Escrow
+	err := api.call(ctx, "get_escrow", []interface{}{from, escrowID}, &resp)
+	return &resp, err
+}
+
+// GetOwnerHistory returns the owner authorities the account had in the past
+func (api *API) GetOwnerHistory(ctx context.Context, account string) ([]*OwnerAuthorityHistory, error) {
+	var resp []*OwnerAuthorityHistory
+	err := api.call(ctx, "get_owner_history", []interface{}{account}, &resp)
+	return resp, err
+}
+
+// GetRecoveryRequest returns the pending recovery request of the account or nil if there is no one
+func (api *API) GetRecoveryRequest(ctx context.Context, account string) (*AccountRecoveryRequest, error) {
+	var resp *AccountRecoveryRequest
+	err := api.call(ctx, "get_recovery_request", []interface{}{account}, &resp)
+	return resp, err
+}
+
+// GetProposals returns the proposals which are not applied or expired yet
+func (api *API) GetProposals(ctx context.Context) ([]*Proposal, error) {
+	var resp []*Proposal
+	err := api.call(ctx, "get_proposals", caller.EmptyParams, &resp)
+	return resp, err
+}
+
+// GetRegistrationCommittee returns registration committee quorums
+func (api *API) GetRegistrationCommittee(ctx context.Context) (*RegistrationCommittee, error) {
+	var resp RegistrationCommittee
+	err := api.call(ctx, "get_registration_committee", caller.EmptyParams, &resp)
+	return &resp, err
+}
+
+// GetDevelopmentCommittee returns development committee quorums and the development pool balance
+func (api *API) GetDevelopmentCommittee(ctx context.Context) (*DevelopmentCommittee, error) {
+	var resp DevelopmentCommittee
+	err := api.call(ctx, "get_development_committee", caller.EmptyParams, &resp)
+	return &resp, err
+}
+
+// LookupRegistrationCommitteeMembers get names of the registration committee members.
+// lowerBoundName Lower bound of the first name to return.
+// limit Maximum number of results to return -- must not exceed 1000
+func (api *API) LookupRegistrationCommitteeMembers(ctx context.Context, lowerBoundName string, limit uint32) ([]string, error) {
+	var resp []string
+	err := api.call(ctx, "lookup_registration_committee_members", []interface{}{lowerBoundName, limit}, &resp)
+	return resp, err
+}
+
+// GetVestingDelegations returns scorumpower delegated by the account.
+// from Lower bound of the delegatee name to return.
+// limit Maximum number of results to return -- must not exceed 1000
+func (api *API) GetVestingDelegations(ctx context.Context, account, from string, limit uint32) ([]*VestingDelegation, error) {
+	var resp []*VestingDelegation
+	err := api.call(ctx, "get_vesting_delegations", []interface{}{account, from, limit}, &resp)
+	return resp, err
+}
+
+// GetExpiringVestingDelegations returns undelegated scorumpower which is about to be returned to the account.
+// from Lower bound of the expiration time to return.
+// limit Maximum number of results to return -- must not exceed 1000
+func (api *API) GetExpiringVestingDelegations(ctx context.Context, account string, from types.Time, limit uint32) ([]*VestingDelegationExpiration, error) {
+	var resp []*VestingDelegationExpiration
+	err := api.call(ctx, "get_expiring_vesting_delegations", []interface{}{account, &from, limit}, &resp)
+	return resp, err
+}
+
+// GetWithdrawRoutes returns scorumpower withdraw routes of the account
+func (api *API) GetWithdrawRoutes(ctx context.Context, account string, routeType WithdrawRouteType) ([]*WithdrawRoute, error) {
+	var resp []*WithdrawRoute
+	err := api.call(ctx, "get_withdraw_routes", []interface{}{account, routeType}, &resp)
+	return resp, err
+}
+
+// GetTransactionHex returns the hex encoded binary representation of the transaction
+func (api *API) GetTransactionHex(ctx context.Context, tx *types.Transaction) (string, error) {
+	var resp string
+	err := api.call(ctx, "get_transaction_hex", []interface{}{tx}, &resp)
+	return resp, err
+}
+
+// GetRequiredSignatures returns the minimal subset of the available keys which is required to sign the transaction
+func (api *API) GetRequiredSignatures(ctx context.Context, tx *types.Transaction, availableKeys []*key.PublicKey) ([]*key.PublicKey, error) {
+	var resp []*key.PublicKey
+	err := api.call(ctx, "get_required_signatures", []interface{}{tx, availableKeys}, &resp)
+	return resp, err
+}
+
+// GetPotentialSignatures returns all keys which could possibly sign the transaction
+func (api *API) GetPotentialSignatures(ctx context.Context, tx *types.Transaction) ([]*key.PublicKey, error) {
+	var resp []*key.PublicKey
+	err := api.call(ctx, "get_potential_signatures", []interface{}{tx}, &resp)
+	return resp, err
+}
+
+// VerifyAuthority returns true if the transaction has all of the required signatures, otherwise an error is returned
+func (api *API) VerifyAuthority(ctx context.Context, tx *types.Transaction) (bool, error) {
+	var resp bool
+	err := api.call(ctx, "verify_authority", []interface{}{tx}, &resp)
+	return resp, err
+}
+
+// VerifyAccountAuthority returns true if the signers have enough authority to authorize the account
+func (api *API) VerifyAccountAuthority(ctx context.Context, account string, signers []*key.PublicKey) (bool, error) {
+	var resp bool
+	err := api.call(ctx, "verify_account_authority", []interface{}{account, signers}, &resp)
+	return resp, err
+}
+
+// GetRewardFund returns the reward fund of the given type
+func (api *API) GetRewardFund(ctx context.Context, fundType RewardFundType) (*RewardFund, error) {
+	var resp RewardFund
+	err := api.call(ctx, "get_reward_fund", []interface{}{fundType}, &resp)
+	return &resp, err
+}
+
+// GetWitnessByAccount returns the witness of the account or nil if the account is not a witness
+func (api *API) GetWitnessByAccount(ctx context.Context, account string) (*Witness, error) {
+	var resp *Witness
+	err := api.call(ctx, "get_witness_by_account", []interface{}{account}, &resp)
+	return resp, err
+}
+
 // Set callback to invoke as soon as a new block is applied
 func (api *API) SetBlockAppliedCallback(notice func(header *types.BlockHeader, error error)) (err error) {
 	err = api.setCallback("set_block_applied_callback", func(raw json.RawMessage) {
@@ -74,3 +215,17 @@ func (api *API) SetBlockAppliedCallback(notice func(header *types.BlockHeader, e
 	})
 	return
 }
+
+// Set callback to invoke as soon as a new transaction is pushed to the node pending pool
+func (api *API) SetPendingTransactionCallback(notice func(tx *types.Transaction, err error)) error {
+	return api.setCallback("set_pending_transaction_callback", func(raw json.RawMessage) {
+		var txs []types.Transaction
+		if err := json.Unmarshal(raw, &txs); err != nil {
+			notice(nil, err)
+			return
+		}
+		for i := range txs {
+			notice(&txs[i], nil)
+		}
+	})
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/scorum/scorum-go/key"
 	"github.com/scorum/scorum-go/rpc"
 )
 
@@ -82,3 +83,24 @@ func TestLookupAccounts(t *testing.T) {
 	})
 
 }
+
+func TestLookupRegistrationCommitteeMembers(t *testing.T) {
+	transport := rpc.NewHTTPTransport(nodeHTTPS)
+	api := NewAPI(transport)
+
+	members, err := api.LookupRegistrationCommitteeMembers(context.Background(), "", 100)
+	require.NoError(t, err)
+	require.True(t, len(members) > 0)
+}
+
+func TestVerifyAccountAuthority(t *testing.T) {
+	transport := rpc.NewHTTPTransport(nodeHTTPS)
+	api := NewAPI(transport)
+
+	pub, err := key.NewPublicKey("SCR7cTf2Dx9rxffs6E2z2pdn5cLMneo3AAFSsF9g4SaVviCYdfQ63")
+	require.NoError(t, err)
+
+	ok, err := api.VerifyAccountAuthority(context.Background(), "azucena", []*key.PublicKey{pub})
+	require.NoError(t, err)
+	require.True(t, ok)
+}
@@ -0,0 +1,68 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/scorum/scorum-go/types"
+)
+
+type callbackCaller struct {
+	method   string
+	callback func(raw json.RawMessage)
+}
+
+func (c *callbackCaller) Call(ctx context.Context, api string, method string, args []interface{}, reply interface{}) error {
+	return nil
+}
+
+func (c *callbackCaller) SetCallback(api string, method string, callback func(raw json.RawMessage)) error {
+	c.method = method
+	c.callback = callback
+	return nil
+}
+
+func TestSetPendingTransactionCallback(t *testing.T) {
+	cc := &callbackCaller{}
+	api := NewAPI(cc)
+
+	var txs []*types.Transaction
+	require.NoError(t, api.SetPendingTransactionCallback(func(tx *types.Transaction, err error) {
+		require.NoError(t, err)
+		txs = append(txs, tx)
+	}))
+	require.Equal(t, "set_pending_transaction_callback", cc.method)
+
+	cc.callback(json.RawMessage(`[{
+		"ref_block_num": 7,
+		"ref_block_prefix": 12345,
+		"expiration": "2018-07-24T11:00:00",
+		"operations": [["transfer", {"from": "alice", "to": "bob", "amount": "1.000000000 SCR", "memo": ""}]],
+		"signatures": []
+	}]`))
+
+	require.Len(t, txs, 1)
+	require.Equal(t, uint16(7), txs[0].RefBlockNum)
+	require.Len(t, txs[0].Operations, 1)
+
+	transfer, ok := txs[0].Operations[0].(*types.TransferOperation)
+	require.True(t, ok)
+	require.Equal(t, "bob", transfer.To)
+}
+
+func TestSetPendingTransactionCallback_InvalidNotice(t *testing.T) {
+	cc := &callbackCaller{}
+	api := NewAPI(cc)
+
+	var errs []error
+	require.NoError(t, api.SetPendingTransactionCallback(func(tx *types.Transaction, err error) {
+		require.Nil(t, tx)
+		errs = append(errs, err)
+	}))
+
+	cc.callback(json.RawMessage(`{}`))
+	require.Len(t, errs, 1)
+}
@@ -2,156 +2,185 @@ package database
 
 import (
 	"encoding/json"
+	"math/big"
+	"time"
 
+	"github.com/scorum/scorum-go/key"
 	"github.com/scorum/scorum-go/types"
 )
 
+// DynamicGlobalProperties is the state of the chain, the fields are decoded leniently, see unmarshalLenient.
 type DynamicGlobalProperties struct {
-	ID                       uint32     `json:"id"`
-	Time                     types.Time `json:"time"`
-	HeadBlockNumber          uint32     `json:"head_block_number"`
-	HeadBlockID              string     `json:"head_block_id"`
-	CurrentWitness           string     `json:"current_witness"`
-	TotalSupply              string     `json:"total_supply"`
-	AccountsCurrentSupply    string     `json:"accounts_current_supply"`
-	ConfidentialSupply       string     `json:"confidential_supply"`
-	TotalVestingFundScorum   string     `json:"total_vesting_fund_scorum"`
-	TotalVestingShares       string     `json:"total_vesting_shares"`
-	TotalRewardShares2       string     `json:"total_reward_shares2"`
-	MaximumBlockSize         int32      `json:"maximum_block_size"`
-	CurrentAslot             int32      `json:"current_aslot"`
-	RecentSlotsFilled        string     `json:"recent_slots_filled"`
-	ParticipationCount       int32      `json:"participation_count"`
-	LastIrreversibleBlockNum uint32     `json:"last_irreversible_block_num"`
-	VotePowerReserveRate     int32      `json:"vote_power_reserve_rate"`
-	InviteQuorum             int32      `json:"invite_quorum"`
-	DropoutQuorum            int32      `json:"dropout_quorum"`
-	ChangeQuorum             int32      `json:"change_quorum"`
-	CurrentReserveRatio      int32      `json:"current_reserve_ratio"`
-	AverageBlockSize         int32      `json:"average_block_size"`
-	MaxVirtualBandwidth      string     `json:"max_virtual_bandwidth"`
+	ID                       uint32      `json:"id"`
+	Time                     types.Time  `json:"time"`
+	HeadBlockNumber          uint32      `json:"head_block_number"`
+	HeadBlockID              string      `json:"head_block_id"`
+	CurrentWitness           string      `json:"current_witness"`
+	TotalSupply              types.Asset `json:"total_supply"`
+	AccountsCurrentSupply    types.Asset `json:"accounts_current_supply"`
+	ConfidentialSupply       types.Asset `json:"confidential_supply"`
+	TotalVestingFundScorum   types.Asset `json:"total_vesting_fund_scorum"`
+	TotalVestingShares       types.Asset `json:"total_vesting_shares"`
+	TotalRewardShares2       *big.Int    `json:"total_reward_shares2"`
+	MaximumBlockSize         int32       `json:"maximum_block_size"`
+	CurrentAslot             int32       `json:"current_aslot"`
+	RecentSlotsFilled        *big.Int    `json:"recent_slots_filled"`
+	ParticipationCount       int32       `json:"participation_count"`
+	LastIrreversibleBlockNum uint32      `json:"last_irreversible_block_num"`
+	VotePowerReserveRate     int32       `json:"vote_power_reserve_rate"`
+	InviteQuorum             int32       `json:"invite_quorum"`
+	DropoutQuorum            int32       `json:"dropout_quorum"`
+	ChangeQuorum             int32       `json:"change_quorum"`
+	CurrentReserveRatio      int32       `json:"current_reserve_ratio"`
+	AverageBlockSize         int32       `json:"average_block_size"`
+	MaxVirtualBandwidth      *big.Int    `json:"max_virtual_bandwidth"`
+
+	// Extra keeps the raw JSON of the fields unknown to the model.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (p *DynamicGlobalProperties) UnmarshalJSON(data []byte) error {
+	return unmarshalLenient(data, p, &p.Extra)
 }
 
+func (p DynamicGlobalProperties) MarshalJSON() ([]byte, error) {
+	return marshalLenient(p, p.Extra)
+}
+
+// Config is the chain configuration, the fields are decoded leniently, see unmarshalLenient.
+// The durations given by the node in microseconds are tagged with unit:"us".
 type Config struct {
-	IsTestNet                                           bool   `json:"IS_TEST_NET"`
-	Scorum100Percent                                    int32  `json:"SCORUM_100_PERCENT"`
-	Scorum1Percent                                      int32  `json:"SCORUM_1_PERCENT"`
-	Scorum1TenthPercent                                 int32  `json:"SCORUM_1_TENTH_PERCENT"`
-	ScorumAccountRecoveryRequestExpirationPeriod        string `json:"SCORUM_ACCOUNT_RECOVERY_REQUEST_EXPIRATION_PERIOD"`
-	ScorumAddressPrefix                                 string `json:"SCORUM_ADDRESS_PREFIX"`
-	ScorumAprPercentMultiplyPerBlock                    string `json:"SCORUM_APR_PERCENT_MULTIPLY_PER_BLOCK"`
-	ScorumAprPercentMultiplyPerHour                     string `json:"SCORUM_APR_PERCENT_MULTIPLY_PER_HOUR"`
-	ScorumAprPercentMultiplyPerRound                    string `json:"SCORUM_APR_PERCENT_MULTIPLY_PER_ROUND"`
-	ScorumAprPercentShiftPerBlock                       int32  `json:"SCORUM_APR_PERCENT_SHIFT_PER_BLOCK"`
-	ScorumAprPercentShiftPerHour                        int32  `json:"SCORUM_APR_PERCENT_SHIFT_PER_HOUR"`
-	ScorumAprPercentShiftPerRound                       int32  `json:"SCORUM_APR_PERCENT_SHIFT_PER_ROUND"`
-	ScorumBandwidthAverageWindowSeconds                 int32  `json:"SCORUM_BANDWIDTH_AVERAGE_WINDOW_SECONDS"`
-	ScorumBandwidthPrecision                            int32  `json:"SCORUM_BANDWIDTH_PRECISION"`
-	ScorumBlockchainHardforkVersion                     string `json:"SCORUM_BLOCKCHAIN_HARDFORK_VERSION"`
-	ScorumBlockchainVersion                             string `json:"SCORUM_BLOCKCHAIN_VERSION"`
-	ScorumBlockInterval                                 int32  `json:"SCORUM_BLOCK_INTERVAL"`
-	ScorumBlocksPerDay                                  int32  `json:"SCORUM_BLOCKS_PER_DAY"`
-	ScorumBlocksPerHour                                 int32  `json:"SCORUM_BLOCKS_PER_HOUR"`
-	ScorumBlocksPerYear                                 int32  `json:"SCORUM_BLOCKS_PER_YEAR"`
-	ScorumCashoutWindowSeconds                          int32  `json:"SCORUM_CASHOUT_WINDOW_SECONDS"`
-	ScorumContentAprPercent                             int32  `json:"SCORUM_CONTENT_APR_PERCENT"`
-	ScorumContentRewardPercent                          int32  `json:"SCORUM_CONTENT_REWARD_PERCENT"`
-	ScorumCreateAccountDelegationRatio                  int32  `json:"SCORUM_CREATE_ACCOUNT_DELEGATION_RATIO"`
-	ScorumCreateAccountDelegationTime                   string `json:"SCORUM_CREATE_ACCOUNT_DELEGATION_TIME"`
-	ScorumCreateAccountWithScorumModifier               int32  `json:"SCORUM_CREATE_ACCOUNT_WITH_SCORUM_MODIFIER"`
-	ScorumHardforkRequiredWitnesses                     int32  `json:"SCORUM_HARDFORK_REQUIRED_WITNESSES"`
-	ScorumInflationNarrowingPeriod                      int32  `json:"SCORUM_INFLATION_NARROWING_PERIOD"`
-	ScorumInflationRateStartPercent                     int32  `json:"SCORUM_INFLATION_RATE_START_PERCENT"`
-	ScorumInflationRateStopPercent                      int32  `json:"SCORUM_INFLATION_RATE_STOP_PERCENT"`
-	ScorumIrreversibleThreshold                         int32  `json:"SCORUM_IRREVERSIBLE_THRESHOLD"`
-	ScorumMaxAccountNameLength                          int32  `json:"SCORUM_MAX_ACCOUNT_NAME_LENGTH"`
-	ScorumMaxAccountWitnessVotes                        int32  `json:"SCORUM_MAX_ACCOUNT_WITNESS_VOTES"`
-	ScorumMaxBlockSize                                  int32  `json:"SCORUM_MAX_BLOCK_SIZE"`
-	ScorumMaxCommentDepth                               int32  `json:"SCORUM_MAX_COMMENT_DEPTH"`
-	ScorumMaxFeedAgeSeconds                             int32  `json:"SCORUM_MAX_FEED_AGE_SECONDS"`
-	ScorumMaxMemoSize                                   int32  `json:"SCORUM_MAX_MEMO_SIZE"`
-	ScorumMaxWitnesses                                  int32  `json:"SCORUM_MAX_WITNESSES"`
-	ScorumMaxPermlinkLength                             int32  `json:"SCORUM_MAX_PERMLINK_LENGTH"`
-	ScorumMaxProxyRecursionDepth                        int32  `json:"SCORUM_MAX_PROXY_RECURSION_DEPTH"`
-	ScorumMaxReserveRatio                               int32  `json:"SCORUM_MAX_RESERVE_RATIO"`
-	ScorumMaxRunnerWitnesses                            int32  `json:"SCORUM_MAX_RUNNER_WITNESSES"`
-	ScorumMaxShareSupply                                string `json:"SCORUM_MAX_SHARE_SUPPLY"`
-	ScorumMaxSigCheckDepth                              int32  `json:"SCORUM_MAX_SIG_CHECK_DEPTH"`
-	ScorumMaxTimeUntilExpiration                        int32  `json:"SCORUM_MAX_TIME_UNTIL_EXPIRATION"`
-	ScorumMaxTransactionSize                            int32  `json:"SCORUM_MAX_TRANSACTION_SIZE"`
-	ScorumMaxUndoHistory                                int32  `json:"SCORUM_MAX_UNDO_HISTORY"`
-	ScorumMaxVoteChanges                                int32  `json:"SCORUM_MAX_VOTE_CHANGES"`
-	ScorumMaxVotedWitnesses                             int32  `json:"SCORUM_MAX_VOTED_WITNESSES"`
-	ScorumMaxWithdrawRoutes                             int32  `json:"SCORUM_MAX_WITHDRAW_ROUTES"`
-	ScorumMaxWitnessUrlLength                           int32  `json:"SCORUM_MAX_WITNESS_URL_LENGTH"`
-	ScorumMinAccountCreationFee                         string `json:"SCORUM_MIN_ACCOUNT_CREATION_FEE"`
-	ScorumMinAccountNameLength                          int32  `json:"SCORUM_MIN_ACCOUNT_NAME_LENGTH"`
-	ScorumMinBlockSizeLimit                             int32  `json:"SCORUM_MIN_BLOCK_SIZE_LIMIT"`
-	ScorumMinContentReward                              string `json:"SCORUM_MIN_CONTENT_REWARD"`
-	ScorumMinCurateReward                               string `json:"SCORUM_MIN_CURATE_REWARD"`
-	ScorumMinPermlinkLength                             int32  `json:"SCORUM_MIN_PERMLINK_LENGTH"`
-	ScorumMinReplyInterval                              int32  `json:"SCORUM_MIN_REPLY_INTERVAL"`
-	ScorumMinRootCommentInterval                        int32  `json:"SCORUM_MIN_ROOT_COMMENT_INTERVAL"`
-	ScorumMinVoteIntervalSec                            int32  `json:"SCORUM_MIN_VOTE_INTERVAL_SEC"`
-	ScorumMinFeeds                                      int32  `json:"SCORUM_MIN_FEEDS"`
-	ScorumDefaultReward                                 string `json:"SCORUM_DEFAULT_REWARD"`
-	ScorumMinPayout                                     string `json:"SCORUM_MIN_PAYOUT"`
-	ScorumMinPowReward                                  string `json:"SCORUM_MIN_POW_REWARD"`
-	ScorumMinProducerReward                             string `json:"SCORUM_MIN_PRODUCER_REWARD"`
-	ScorumMinTransactionExpirationLimit                 int32  `json:"SCORUM_MIN_TRANSACTION_EXPIRATION_LIMIT"`
-	ScorumMinUndoHistory                                int32  `json:"SCORUM_MIN_UNDO_HISTORY"`
-	ScorumNumInitDelegates                              int32  `json:"SCORUM_NUM_INIT_DELEGATES"`
-	ScorumOwnerAuthHistoryTrackingStartBlockNum         int32  `json:"SCORUM_OWNER_AUTH_HISTORY_TRACKING_START_BLOCK_NUM"`
-	ScorumOwnerAuthRecoveryPeriod                       string `json:"SCORUM_OWNER_AUTH_RECOVERY_PERIOD"`
-	ScorumOwnerUpdateLimit                              int64  `json:"SCORUM_OWNER_UPDATE_LIMIT"`
-	ScorumPowAprPercent                                 int32  `json:"SCORUM_POW_APR_PERCENT"`
-	ScorumProducerAprPercent                            int32  `json:"SCORUM_PRODUCER_APR_PERCENT"`
-	ScorumProxyToSelfAccount                            string `json:"SCORUM_PROXY_TO_SELF_ACCOUNT"`
-	ScorumRecentRsharesDecayRate                        string `json:"SCORUM_RECENT_RSHARES_DECAY_RATE"`
-	ScorumReverseAuctionWindowSeconds                   int32  `json:"SCORUM_REVERSE_AUCTION_WINDOW_SECONDS"`
-	ScorumRootPostParent                                string `json:"SCORUM_ROOT_POST_PARENT"`
-	ScorumSavingsWithdrawRequestLimit                   int32  `json:"SCORUM_SAVINGS_WITHDRAW_REQUEST_LIMIT"`
-	ScorumSavingsWithdrawTime                           string `json:"SCORUM_SAVINGS_WITHDRAW_TIME"`
-	ScorumSoftMaxCommentDepth                           int32  `json:"SCORUM_SOFT_MAX_COMMENT_DEPTH"`
-	ScorumStartMinerVotingBlock                         int32  `json:"SCORUM_START_MINER_VOTING_BLOCK"`
-	ScorumStartVestingBlock                             int32  `json:"SCORUM_START_VESTING_BLOCK"`
-	ScorumUpvoteLockout                                 int32  `json:"SCORUM_UPVOTE_LOCKOUT"`
-	ScorumVestingFundPercent                            int32  `json:"SCORUM_VESTING_FUND_PERCENT"`
-	ScorumVestingWithdrawIntervals                      int32  `json:"SCORUM_VESTING_WITHDRAW_INTERVALS"`
-	ScorumVestingWithdrawIntervalsPreHf16               int32  `json:"SCORUM_VESTING_WITHDRAW_INTERVALS_PRE_HF_16"`
-	ScorumVestingWithdrawIntervalSeconds                int32  `json:"SCORUM_VESTING_WITHDRAW_INTERVAL_SECONDS"`
-	ScorumVoteDustThreshold                             int32  `json:"SCORUM_VOTE_DUST_THRESHOLD"`
-	ScorumVoteRegenerationSeconds                       string `json:"SCORUM_VOTE_REGENERATION_SECONDS"`
-	ScorumSymbol                                        int32  `json:"SCORUM_SYMBOL"`
-	VestsSymbol                                         int32  `json:"VESTS_SYMBOL"`
-	VirtualScheduleLapLength                            string `json:"VIRTUAL_SCHEDULE_LAP_LENGTH"`
-	ScorumRewardsInitialSupply                          string `json:"SCORUM_REWARDS_INITIAL_SUPPLY"`
-	ScorumRewardsInitialSupplyPeriodInDays              int32  `json:"SCORUM_REWARDS_INITIAL_SUPPLY_PERIOD_IN_DAYS"`
-	ScorumGuarantedRewardSupplyPeriodInDays             int32  `json:"SCORUM_GUARANTED_REWARD_SUPPLY_PERIOD_IN_DAYS"`
-	ScorumRewardIncreaseThresholdInDays                 int32  `json:"SCORUM_REWARD_INCREASE_THRESHOLD_IN_DAYS"`
-	ScorumAdjustRewardPercent                           int32  `json:"SCORUM_ADJUST_REWARD_PERCENT"`
-	ScorumBudgetLimitCountPerOwner                      int32  `json:"SCORUM_BUDGET_LIMIT_COUNT_PER_OWNER"`
-	ScorumBudgetLimitDbListSize                         int32  `json:"SCORUM_BUDGET_LIMIT_DB_LIST_SIZE"`
-	ScorumBudgetLimitApiListSize                        int32  `json:"SCORUM_BUDGET_LIMIT_API_LIST_SIZE"`
-	ScorumRegistrationBonusLimitPerMemberNBlock         int32  `json:"SCORUM_REGISTRATION_BONUS_LIMIT_PER_MEMBER_N_BLOCK"`
-	ScorumRegistrationBonusLimitPerMemberPerNBlock      int32  `json:"SCORUM_REGISTRATION_BONUS_LIMIT_PER_MEMBER_PER_N_BLOCK"`
-	ScorumRegistrationLimitCountCommitteeMembers        int32  `json:"SCORUM_REGISTRATION_LIMIT_COUNT_COMMITTEE_MEMBERS"`
-	ScorumWitnessMissedBlocksThreshold                  int32  `json:"SCORUM_WITNESS_MISSED_BLOCKS_THRESHOLD"`
-	ScorumAtomicswapInitiatorRefundLockSecs             int32  `json:"SCORUM_ATOMICSWAP_INITIATOR_REFUND_LOCK_SECS"`
-	ScorumAtomicswapParticipantRefundLockSecs           int32  `json:"SCORUM_ATOMICSWAP_PARTICIPANT_REFUND_LOCK_SECS"`
-	ScorumAtomicswapLimitRequestedContractsPerOwner     int32  `json:"SCORUM_ATOMICSWAP_LIMIT_REQUESTED_CONTRACTS_PER_OWNER"`
-	ScorumAtomicswapLimitRequestedContractsPerRecipient int32  `json:"SCORUM_ATOMICSWAP_LIMIT_REQUESTED_CONTRACTS_PER_RECIPIENT"`
-	ScorumAtomicswapContractMetadataMaxLength           int32  `json:"SCORUM_ATOMICSWAP_CONTRACT_METADATA_MAX_LENGTH"`
-	ScorumAtomicswapSecretMaxLength                     int32  `json:"SCORUM_ATOMICSWAP_SECRET_MAX_LENGTH"`
+	IsTestNet                                           bool          `json:"IS_TEST_NET"`
+	Scorum100Percent                                    int32         `json:"SCORUM_100_PERCENT"`
+	Scorum1Percent                                      int32         `json:"SCORUM_1_PERCENT"`
+	Scorum1TenthPercent                                 int32         `json:"SCORUM_1_TENTH_PERCENT"`
+	ScorumAccountRecoveryRequestExpirationPeriod        time.Duration `json:"SCORUM_ACCOUNT_RECOVERY_REQUEST_EXPIRATION_PERIOD" unit:"us"`
+	ScorumAddressPrefix                                 string        `json:"SCORUM_ADDRESS_PREFIX"`
+	ScorumAprPercentMultiplyPerBlock                    uint64        `json:"SCORUM_APR_PERCENT_MULTIPLY_PER_BLOCK"`
+	ScorumAprPercentMultiplyPerHour                     uint64        `json:"SCORUM_APR_PERCENT_MULTIPLY_PER_HOUR"`
+	ScorumAprPercentMultiplyPerRound                    uint64        `json:"SCORUM_APR_PERCENT_MULTIPLY_PER_ROUND"`
+	ScorumAprPercentShiftPerBlock                       int32         `json:"SCORUM_APR_PERCENT_SHIFT_PER_BLOCK"`
+	ScorumAprPercentShiftPerHour                        int32         `json:"SCORUM_APR_PERCENT_SHIFT_PER_HOUR"`
+	ScorumAprPercentShiftPerRound                       int32         `json:"SCORUM_APR_PERCENT_SHIFT_PER_ROUND"`
+	ScorumBandwidthAverageWindowSeconds                 time.Duration `json:"SCORUM_BANDWIDTH_AVERAGE_WINDOW_SECONDS"`
+	ScorumBandwidthPrecision                            int32         `json:"SCORUM_BANDWIDTH_PRECISION"`
+	ScorumBlockchainHardforkVersion                     string        `json:"SCORUM_BLOCKCHAIN_HARDFORK_VERSION"`
+	ScorumBlockchainVersion                             string        `json:"SCORUM_BLOCKCHAIN_VERSION"`
+	ScorumBlockInterval                                 time.Duration `json:"SCORUM_BLOCK_INTERVAL"`
+	ScorumBlocksPerDay                                  int32         `json:"SCORUM_BLOCKS_PER_DAY"`
+	ScorumBlocksPerHour                                 int32         `json:"SCORUM_BLOCKS_PER_HOUR"`
+	ScorumBlocksPerYear                                 int32         `json:"SCORUM_BLOCKS_PER_YEAR"`
+	ScorumCashoutWindowSeconds                          time.Duration `json:"SCORUM_CASHOUT_WINDOW_SECONDS"`
+	ScorumContentAprPercent                             int32         `json:"SCORUM_CONTENT_APR_PERCENT"`
+	ScorumContentRewardPercent                          int32         `json:"SCORUM_CONTENT_REWARD_PERCENT"`
+	ScorumCreateAccountDelegationRatio                  int32         `json:"SCORUM_CREATE_ACCOUNT_DELEGATION_RATIO"`
+	ScorumCreateAccountDelegationTime                   time.Duration `json:"SCORUM_CREATE_ACCOUNT_DELEGATION_TIME" unit:"us"`
+	ScorumCreateAccountWithScorumModifier               int32         `json:"SCORUM_CREATE_ACCOUNT_WITH_SCORUM_MODIFIER"`
+	ScorumHardforkRequiredWitnesses                     int32         `json:"SCORUM_HARDFORK_REQUIRED_WITNESSES"`
+	ScorumInflationNarrowingPeriod                      int32         `json:"SCORUM_INFLATION_NARROWING_PERIOD"`
+	ScorumInflationRateStartPercent                     int32         `json:"SCORUM_INFLATION_RATE_START_PERCENT"`
+	ScorumInflationRateStopPercent                      int32         `json:"SCORUM_INFLATION_RATE_STOP_PERCENT"`
+	ScorumIrreversibleThreshold                         int32         `json:"SCORUM_IRREVERSIBLE_THRESHOLD"`
+	ScorumMaxAccountNameLength                          int32         `json:"SCORUM_MAX_ACCOUNT_NAME_LENGTH"`
+	ScorumMaxAccountWitnessVotes                        int32         `json:"SCORUM_MAX_ACCOUNT_WITNESS_VOTES"`
+	ScorumMaxBlockSize                                  int32         `json:"SCORUM_MAX_BLOCK_SIZE"`
+	ScorumMaxCommentDepth                               int32         `json:"SCORUM_MAX_COMMENT_DEPTH"`
+	ScorumMaxFeedAgeSeconds                             time.Duration `json:"SCORUM_MAX_FEED_AGE_SECONDS"`
+	ScorumMaxMemoSize                                   int32         `json:"SCORUM_MAX_MEMO_SIZE"`
+	ScorumMaxWitnesses                                  int32         `json:"SCORUM_MAX_WITNESSES"`
+	ScorumMaxPermlinkLength                             int32         `json:"SCORUM_MAX_PERMLINK_LENGTH"`
+	ScorumMaxProxyRecursionDepth                        int32         `json:"SCORUM_MAX_PROXY_RECURSION_DEPTH"`
+	ScorumMaxReserveRatio                               int32         `json:"SCORUM_MAX_RESERVE_RATIO"`
+	ScorumMaxRunnerWitnesses                            int32         `json:"SCORUM_MAX_RUNNER_WITNESSES"`
+	ScorumMaxShareSupply                                int64         `json:"SCORUM_MAX_SHARE_SUPPLY"`
+	ScorumMaxSigCheckDepth                              int32         `json:"SCORUM_MAX_SIG_CHECK_DEPTH"`
+	ScorumMaxTimeUntilExpiration                        time.Duration `json:"SCORUM_MAX_TIME_UNTIL_EXPIRATION"`
+	ScorumMaxTransactionSize                            int32         `json:"SCORUM_MAX_TRANSACTION_SIZE"`
+	ScorumMaxUndoHistory                                int32         `json:"SCORUM_MAX_UNDO_HISTORY"`
+	ScorumMaxVoteChanges                                int32         `json:"SCORUM_MAX_VOTE_CHANGES"`
+	ScorumMaxVotedWitnesses                             int32         `json:"SCORUM_MAX_VOTED_WITNESSES"`
+	ScorumMaxWithdrawRoutes                             int32         `json:"SCORUM_MAX_WITHDRAW_ROUTES"`
+	ScorumMaxWitnessUrlLength                           int32         `json:"SCORUM_MAX_WITNESS_URL_LENGTH"`
+	ScorumMinAccountCreationFee                         types.Asset   `json:"SCORUM_MIN_ACCOUNT_CREATION_FEE"`
+	ScorumMinAccountNameLength                          int32         `json:"SCORUM_MIN_ACCOUNT_NAME_LENGTH"`
+	ScorumMinBlockSizeLimit                             int32         `json:"SCORUM_MIN_BLOCK_SIZE_LIMIT"`
+	ScorumMinContentReward                              types.Asset   `json:"SCORUM_MIN_CONTENT_REWARD"`
+	ScorumMinCurateReward                               types.Asset   `json:"SCORUM_MIN_CURATE_REWARD"`
+	ScorumMinPermlinkLength                             int32         `json:"SCORUM_MIN_PERMLINK_LENGTH"`
+	ScorumMinReplyInterval                              time.Duration `json:"SCORUM_MIN_REPLY_INTERVAL" unit:"us"`
+	ScorumMinRootCommentInterval                        time.Duration `json:"SCORUM_MIN_ROOT_COMMENT_INTERVAL" unit:"us"`
+	ScorumMinVoteIntervalSec                            time.Duration `json:"SCORUM_MIN_VOTE_INTERVAL_SEC"`
+	ScorumMinFeeds                                      int32         `json:"SCORUM_MIN_FEEDS"`
+	ScorumDefaultReward                                 types.Asset   `json:"SCORUM_DEFAULT_REWARD"`
+	ScorumMinPayout                                     types.Asset   `json:"SCORUM_MIN_PAYOUT"`
+	ScorumMinPowReward                                  types.Asset   `json:"SCORUM_MIN_POW_REWARD"`
+	ScorumMinProducerReward                             types.Asset   `json:"SCORUM_MIN_PRODUCER_REWARD"`
+	ScorumMinTransactionExpirationLimit                 time.Duration `json:"SCORUM_MIN_TRANSACTION_EXPIRATION_LIMIT"`
+	ScorumMinUndoHistory                                int32         `json:"SCORUM_MIN_UNDO_HISTORY"`
+	ScorumNumInitDelegates                              int32         `json:"SCORUM_NUM_INIT_DELEGATES"`
+	ScorumOwnerAuthHistoryTrackingStartBlockNum         int32         `json:"SCORUM_OWNER_AUTH_HISTORY_TRACKING_START_BLOCK_NUM"`
+	ScorumOwnerAuthRecoveryPeriod                       time.Duration `json:"SCORUM_OWNER_AUTH_RECOVERY_PERIOD" unit:"us"`
+	ScorumOwnerUpdateLimit                              time.Duration `json:"SCORUM_OWNER_UPDATE_LIMIT" unit:"us"`
+	ScorumPowAprPercent                                 int32         `json:"SCORUM_POW_APR_PERCENT"`
+	ScorumProducerAprPercent                            int32         `json:"SCORUM_PRODUCER_APR_PERCENT"`
+	ScorumProxyToSelfAccount                            string        `json:"SCORUM_PROXY_TO_SELF_ACCOUNT"`
+	ScorumRecentRsharesDecayRate                        time.Duration `json:"SCORUM_RECENT_RSHARES_DECAY_RATE" unit:"us"`
+	ScorumReverseAuctionWindowSeconds                   time.Duration `json:"SCORUM_REVERSE_AUCTION_WINDOW_SECONDS"`
+	ScorumRootPostParent                                string        `json:"SCORUM_ROOT_POST_PARENT"`
+	ScorumSavingsWithdrawRequestLimit                   int32         `json:"SCORUM_SAVINGS_WITHDRAW_REQUEST_LIMIT"`
+	ScorumSavingsWithdrawTime                           time.Duration `json:"SCORUM_SAVINGS_WITHDRAW_TIME" unit:"us"`
+	ScorumSoftMaxCommentDepth                           int32         `json:"SCORUM_SOFT_MAX_COMMENT_DEPTH"`
+	ScorumStartMinerVotingBlock                         int32         `json:"SCORUM_START_MINER_VOTING_BLOCK"`
+	ScorumStartVestingBlock                             int32         `json:"SCORUM_START_VESTING_BLOCK"`
+	ScorumUpvoteLockout                                 time.Duration `json:"SCORUM_UPVOTE_LOCKOUT" unit:"us"`
+	ScorumVestingFundPercent                            int32         `json:"SCORUM_VESTING_FUND_PERCENT"`
+	ScorumVestingWithdrawIntervals                      int32         `json:"SCORUM_VESTING_WITHDRAW_INTERVALS"`
+	ScorumVestingWithdrawIntervalsPreHf16               int32         `json:"SCORUM_VESTING_WITHDRAW_INTERVALS_PRE_HF_16"`
+	ScorumVestingWithdrawIntervalSeconds                time.Duration `json:"SCORUM_VESTING_WITHDRAW_INTERVAL_SECONDS"`
+	ScorumVoteDustThreshold                             int32         `json:"SCORUM_VOTE_DUST_THRESHOLD"`
+	ScorumVoteRegenerationSeconds                       time.Duration `json:"SCORUM_VOTE_REGENERATION_SECONDS"`
+	ScorumSymbol                                        uint64        `json:"SCORUM_SYMBOL"`
+	VestsSymbol                                         uint64        `json:"VESTS_SYMBOL"`
+	VirtualScheduleLapLength                            *big.Int      `json:"VIRTUAL_SCHEDULE_LAP_LENGTH"`
+	ScorumRewardsInitialSupply                          types.Asset   `json:"SCORUM_REWARDS_INITIAL_SUPPLY"`
+	ScorumRewardsInitialSupplyPeriodInDays              int32         `json:"SCORUM_REWARDS_INITIAL_SUPPLY_PERIOD_IN_DAYS"`
+	ScorumGuarantedRewardSupplyPeriodInDays             int32         `json:"SCORUM_GUARANTED_REWARD_SUPPLY_PERIOD_IN_DAYS"`
+	ScorumRewardIncreaseThresholdInDays                 int32         `json:"SCORUM_REWARD_INCREASE_THRESHOLD_IN_DAYS"`
+	ScorumAdjustRewardPercent                           int32         `json:"SCORUM_ADJUST_REWARD_PERCENT"`
+	ScorumBudgetLimitCountPerOwner                      int32         `json:"SCORUM_BUDGET_LIMIT_COUNT_PER_OWNER"`
+	ScorumBudgetLimitDbListSize                         int32         `json:"SCORUM_BUDGET_LIMIT_DB_LIST_SIZE"`
+	ScorumBudgetLimitApiListSize                        int32         `json:"SCORUM_BUDGET_LIMIT_API_LIST_SIZE"`
+	ScorumRegistrationBonusLimitPerMemberNBlock         int32         `json:"SCORUM_REGISTRATION_BONUS_LIMIT_PER_MEMBER_N_BLOCK"`
+	ScorumRegistrationBonusLimitPerMemberPerNBlock      int32         `json:"SCORUM_REGISTRATION_BONUS_LIMIT_PER_MEMBER_PER_N_BLOCK"`
+	ScorumRegistrationLimitCountCommitteeMembers        int32         `json:"SCORUM_REGISTRATION_LIMIT_COUNT_COMMITTEE_MEMBERS"`
+	ScorumWitnessMissedBlocksThreshold                  int32         `json:"SCORUM_WITNESS_MISSED_BLOCKS_THRESHOLD"`
+	ScorumAtomicswapInitiatorRefundLockSecs             time.Duration `json:"SCORUM_ATOMICSWAP_INITIATOR_REFUND_LOCK_SECS"`
+	ScorumAtomicswapParticipantRefundLockSecs           time.Duration `json:"SCORUM_ATOMICSWAP_PARTICIPANT_REFUND_LOCK_SECS"`
+	ScorumAtomicswapLimitRequestedContractsPerOwner     int32         `json:"SCORUM_ATOMICSWAP_LIMIT_REQUESTED_CONTRACTS_PER_OWNER"`
+	ScorumAtomicswapLimitRequestedContractsPerRecipient int32         `json:"SCORUM_ATOMICSWAP_LIMIT_REQUESTED_CONTRACTS_PER_RECIPIENT"`
+	ScorumAtomicswapContractMetadataMaxLength           int32         `json:"SCORUM_ATOMICSWAP_CONTRACT_METADATA_MAX_LENGTH"`
+	ScorumAtomicswapSecretMaxLength                     int32         `json:"SCORUM_ATOMICSWAP_SECRET_MAX_LENGTH"`
+
+	// Extra keeps the raw JSON of the fields unknown to the model.
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
+func (c *Config) UnmarshalJSON(data []byte) error {
+	return unmarshalLenient(data, c, &c.Extra)
+}
+
+func (c Config) MarshalJSON() ([]byte, error) {
+	return marshalLenient(c, c.Extra)
+}
+
+// Account is the account object, the fields are decoded leniently, see unmarshalLenient.
 type Account struct {
 	ID                        uint32            `json:"id"`
 	Name                      string            `json:"name"`
 	Owner                     types.Authority   `json:"owner"`
 	Active                    types.Authority   `json:"active"`
 	Posting                   types.Authority   `json:"posting"`
-	MemoKey                   string            `json:"memo_key"`
+	MemoKey                   *key.PublicKey    `json:"memo_key"`
 	JsonMetadata              string            `json:"json_metadata"`
 	Proxy                     string            `json:"proxy"`
 	LastOwnerUpdate           types.Time        `json:"last_owner_update"`
@@ -170,25 +199,27 @@ type Account struct {
 	CanVote                   bool              `json:"can_vote"`
 	VotingPower               int32             `json:"voting_power"`
 	LastVoteTime              types.Time        `json:"last_vote_time"`
-	Balance                   string            `json:"balance"`
-	VestingShares             string            `json:"vesting_shares"`
-	DelegatedVestingShares    string            `json:"delegated_vesting_shares"`
-	ReceivedVestingShares     string            `json:"received_vesting_shares"`
-	VestingWithdrawRate       string            `json:"vesting_withdraw_rate"`
+	Balance                   types.Asset       `json:"balance"`
+	VestingShares             types.Asset       `json:"vesting_shares"`
+	DelegatedVestingShares    types.Asset       `json:"delegated_vesting_shares"`
+	ReceivedVestingShares     types.Asset       `json:"received_vesting_shares"`
+	VestingWithdrawRate       types.Asset       `json:"vesting_withdraw_rate"`
 	NextVestingWithdrawal     types.Time        `json:"next_vesting_withdrawal"`
-	CurationRewards           string            `json:"curation_rewards"`
-	PostingRewards            string            `json:"posting_rewards"`
-	ProxiedVsfVotes           []json.RawMessage `json:"proxied_vsf_votes"`
-	WitnessesVotedFor         json.RawMessage   `json:"witnesses_voted_for"`
-	AverageBandwidth          json.RawMessage   `json:"average_bandwidth"`
-	LifetimeBandwidth         json.RawMessage   `json:"lifetime_bandwidth"`
+	Withdrawn                 types.Asset       `json:"withdrawn"`
+	ToWithdraw                types.Asset       `json:"to_withdraw"`
+	CurationRewards           types.Asset       `json:"curation_rewards"`
+	PostingRewards            types.Asset       `json:"posting_rewards"`
+	ProxiedVsfVotes           []*big.Int        `json:"proxied_vsf_votes"`
+	WitnessesVotedFor         int32             `json:"witnesses_voted_for"`
+	AverageBandwidth          *big.Int          `json:"average_bandwidth"`
+	LifetimeBandwidth         *big.Int          `json:"lifetime_bandwidth"`
 	LastBandwidthUpdate       types.Time        `json:"last_bandwidth_update"`
-	AverageMarketBandwidth    json.RawMessage   `json:"average_market_bandwidth"`
-	LifetimeMarketBandwidth   json.RawMessage   `json:"lifetime_market_bandwidth"`
+	AverageMarketBandwidth    *big.Int          `json:"average_market_bandwidth"`
+	LifetimeMarketBandwidth   *big.Int          `json:"lifetime_market_bandwidth"`
 	LastMarketBandwidthUpdate types.Time        `json:"last_market_bandwidth_update"`
 	LastPost                  types.Time        `json:"last_post"`
 	LastRootPost              types.Time        `json:"last_root_post"`
-	VestingBalance            string            `json:"vesting_balance"`
+	VestingBalance            types.Asset       `json:"vesting_balance"`
 	TransferHistory           []json.RawMessage `json:"transfer_history"`
 	PostHistory               []json.RawMessage `json:"post_history"`
 	VoteHistory               []json.RawMessage `json:"vote_history"`
@@ -196,4 +227,150 @@ type Account struct {
 	WitnessVotes              []string          `json:"witness_votes"`
 	TagsUsage                 []json.RawMessage `json:"tags_usage"`
 	GuestBloggers             []json.RawMessage `json:"guest_bloggers"`
+
+	// Extra keeps the raw JSON of the fields unknown to the model.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (a *Account) UnmarshalJSON(data []byte) error {
+	return unmarshalLenient(data, a, &a.Extra)
+}
+
+func (a Account) MarshalJSON() ([]byte, error) {
+	return marshalLenient(a, a.Extra)
+}
+
+type AtomicSwapContract struct {
+	ID                uint32      `json:"id"`
+	ContractInitiator bool        `json:"contract_initiator"`
+	Owner             string      `json:"owner"`
+	To                string      `json:"to"`
+	Amount            types.Asset `json:"amount"`
+	Created           types.Time  `json:"created"`
+	Deadline          types.Time  `json:"deadline"`
+	Metadata          string      `json:"metadata"`
+}
+
+type AtomicSwapContractInfo struct {
+	AtomicSwapContract
+	SecretHash string `json:"secret_hash"`
+	Secret     string `json:"secret"`
+}
+
+type OwnerAuthorityHistory struct {
+	ID                     uint32          `json:"id"`
+	Account                string          `json:"account"`
+	PreviousOwnerAuthority types.Authority `json:"previous_owner_authority"`
+	LastValidTime          types.Time      `json:"last_valid_time"`
+}
+
+type AccountRecoveryRequest struct {
+	ID                uint32          `json:"id"`
+	AccountToRecover  string          `json:"account_to_recover"`
+	NewOwnerAuthority types.Authority `json:"new_owner_authority"`
+	Expires           types.Time      `json:"expires"`
+}
+
+type Proposal struct {
+	ID            uint32                  `json:"id"`
+	Creator       string                  `json:"creator"`
+	Operation     types.ProposalOperation `json:"operation"`
+	Created       types.Time              `json:"created"`
+	Expiration    types.Time              `json:"expiration"`
+	Quorum        uint32                  `json:"quorum"`
+	VotedAccounts []string                `json:"voted_accounts"`
+}
+
+type RegistrationCommittee struct {
+	InviteQuorum  uint32 `json:"invite_quorum"`
+	DropoutQuorum uint32 `json:"dropout_quorum"`
+	ChangeQuorum  uint32 `json:"change_quorum"`
+}
+
+type DevelopmentCommittee struct {
+	SPBalance                      types.Asset `json:"sp_balance"`
+	SCRBalance                     types.Asset `json:"scr_balance"`
+	InviteQuorum                   uint32      `json:"invite_quorum"`
+	DropoutQuorum                  uint32      `json:"dropout_quorum"`
+	ChangeQuorum                   uint32      `json:"change_quorum"`
+	BaseQuorum                     uint32      `json:"base_quorum"`
+	TransferQuorum                 uint32      `json:"transfer_quorum"`
+	AdvertisingModeratorQuorum     uint32      `json:"advertising_moderator_quorum"`
+	BudgetsAuctionPropertiesQuorum uint32      `json:"budgets_auction_properties_quorum"`
+	BettingModeratorQuorum         uint32      `json:"betting_moderator_quorum"`
+	BettingResolveDelayQuorum      uint32      `json:"betting_resolve_delay_quorum"`
+}
+
+type VestingDelegation struct {
+	ID                uint32      `json:"id"`
+	Delegator         string      `json:"delegator"`
+	Delegatee         string      `json:"delegatee"`
+	VestingShares     types.Asset `json:"vesting_shares"`
+	MinDelegationTime types.Time  `json:"min_delegation_time"`
+}
+
+type VestingDelegationExpiration struct {
+	ID            uint32      `json:"id"`
+	Delegator     string      `json:"delegator"`
+	VestingShares types.Asset `json:"vesting_shares"`
+	Expiration    types.Time  `json:"expiration"`
+}
+
+type WithdrawRouteType string
+
+const (
+	IncomingWithdrawRoute WithdrawRouteType = "incoming"
+	OutgoingWithdrawRoute WithdrawRouteType = "outgoing"
+	AllWithdrawRoute      WithdrawRouteType = "all"
+)
+
+type WithdrawRoute struct {
+	FromAccount string `json:"from_account"`
+	ToAccount   string `json:"to_account"`
+	Percent     uint16 `json:"percent"`
+	AutoVest    bool   `json:"auto_vest"`
+}
+
+type RewardFundType string
+
+const (
+	ContentRewardFundSCR                    RewardFundType = "content_reward_fund_scr"
+	ContentRewardFundSP                     RewardFundType = "content_reward_fund_sp"
+	VotersRewardFundSCR                     RewardFundType = "voters_reward_fund_scr"
+	VotersRewardFundSP                      RewardFundType = "voters_reward_fund_sp"
+	ContentFifaWorldCup2018BountyRewardFund RewardFundType = "content_fifa_world_cup_2018_bounty_reward_fund"
+)
+
+// RewardFund balances are denominated either in SCR or in SP depending on the fund type.
+type RewardFund struct {
+	ID                    uint32      `json:"id"`
+	ActivityRewardBalance types.Asset `json:"activity_reward_balance"`
+	RecentClaims          string      `json:"recent_claims"`
+	LastUpdate            types.Time  `json:"last_update"`
+	AuthorRewardCurve     string      `json:"author_reward_curve"`
+	CurationRewardCurve   string      `json:"curation_reward_curve"`
+}
+
+type WitnessChainProperties struct {
+	AccountCreationFee types.Asset `json:"account_creation_fee"`
+	MaximumBlockSize   uint32      `json:"maximum_block_size"`
+}
+
+type Witness struct {
+	ID                    uint32                 `json:"id"`
+	Owner                 string                 `json:"owner"`
+	Created               types.Time             `json:"created"`
+	Url                   string                 `json:"url"`
+	Votes                 int64                  `json:"votes"`
+	VirtualLastUpdate     string                 `json:"virtual_last_update"`
+	VirtualPosition       string                 `json:"virtual_position"`
+	VirtualScheduledTime  string                 `json:"virtual_scheduled_time"`
+	TotalMissed           uint32                 `json:"total_missed"`
+	LastAslot             uint64                 `json:"last_aslot"`
+	LastConfirmedBlockNum uint64                 `json:"last_confirmed_block_num"`
+	SigningKey            types.PublicKey        `json:"signing_key"`
+	Props                 WitnessChainProperties `json:"proposed_chain_props"`
+	RunningVersion        string                 `json:"running_version"`
+	HardforkVersionVote   string                 `json:"hardfork_version_vote"`
+	HardforkTimeVote      types.Time             `json:"hardfork_time_vote"`
 }
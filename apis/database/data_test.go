@@ -0,0 +1,205 @@
+package database
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/scorum/scorum-go/types"
+)
+
+func mustAsset(t *testing.T, value string) types.Asset {
+	asset, err := types.AssetFromString(value)
+	require.NoError(t, err)
+	return *asset
+}
+
+func TestDynamicGlobalProperties_UnmarshalJSON(t *testing.T) {
+	data := `{
+		"id": 0,
+		"head_block_number": "4294967296",
+		"time": "2018-05-01T00:00:00",
+		"total_supply": "100.000000000 SCR",
+		"total_vesting_shares": "25.000000000 SP",
+		"total_reward_shares2": "340282366920938463463374607431768211455",
+		"recent_slots_filled": 1024,
+		"maximum_block_size": "65536",
+		"registration_pool_balance": "10.000000000 SCR"
+	}`
+
+	var props DynamicGlobalProperties
+	err := json.Unmarshal([]byte(data), &props)
+	require.Error(t, err, "head_block_number overflows uint32")
+
+	data = `{
+		"head_block_number": "42",
+		"time": "2018-05-01T00:00:00",
+		"total_supply": "100.000000000 SCR",
+		"total_vesting_shares": "25.000000000 SP",
+		"total_reward_shares2": "340282366920938463463374607431768211455",
+		"recent_slots_filled": 1024,
+		"maximum_block_size": "65536",
+		"max_virtual_bandwidth": null,
+		"registration_pool_balance": "10.000000000 SCR"
+	}`
+
+	props = DynamicGlobalProperties{}
+	require.NoError(t, json.Unmarshal([]byte(data), &props))
+	require.EqualValues(t, 42, props.HeadBlockNumber)
+	require.Equal(t, time.Date(2018, 5, 1, 0, 0, 0, 0, time.UTC), props.Time.Time)
+	require.Equal(t, mustAsset(t, "100.000000000 SCR"), props.TotalSupply)
+	require.Equal(t, mustAsset(t, "25.000000000 SP"), props.TotalVestingShares)
+	require.Equal(t, "340282366920938463463374607431768211455", props.TotalRewardShares2.String())
+	require.EqualValues(t, 1024, props.RecentSlotsFilled.Int64())
+	require.EqualValues(t, 65536, props.MaximumBlockSize)
+	require.Nil(t, props.MaxVirtualBandwidth)
+	require.Equal(t, map[string]json.RawMessage{
+		"registration_pool_balance": json.RawMessage(`"10.000000000 SCR"`),
+	}, props.Extra)
+}
+
+func TestConfig_UnmarshalJSON(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		data := `{
+			"IS_TEST_NET": false,
+			"SCORUM_CASHOUT_WINDOW_SECONDS": 7200,
+			"SCORUM_VOTE_REGENERATION_SECONDS": "432000",
+			"SCORUM_OWNER_AUTH_RECOVERY_PERIOD": "2592000000000",
+			"SCORUM_UPVOTE_LOCKOUT": 60000000,
+			"SCORUM_MAX_SHARE_SUPPLY": "1000000000000000",
+			"SCORUM_SYMBOL": "42288069296647",
+			"SCORUM_MIN_ACCOUNT_CREATION_FEE": "0.000750000 SCR",
+			"VIRTUAL_SCHEDULE_LAP_LENGTH": "340282366920938463463374607431768211455",
+			"SCORUM_ADDRESS_PREFIX": "SCR"
+		}`
+
+		var config Config
+		require.NoError(t, json.Unmarshal([]byte(data), &config))
+		require.Equal(t, 2*time.Hour, config.ScorumCashoutWindowSeconds)
+		require.Equal(t, 5*24*time.Hour, config.ScorumVoteRegenerationSeconds)
+		require.Equal(t, 30*24*time.Hour, config.ScorumOwnerAuthRecoveryPeriod)
+		require.Equal(t, time.Minute, config.ScorumUpvoteLockout)
+		require.EqualValues(t, 1000000000000000, config.ScorumMaxShareSupply)
+		require.EqualValues(t, 42288069296647, config.ScorumSymbol)
+		require.Equal(t, mustAsset(t, "0.000750000 SCR"), config.ScorumMinAccountCreationFee)
+		require.Equal(t, "340282366920938463463374607431768211455", config.VirtualScheduleLapLength.String())
+		require.Equal(t, "SCR", config.ScorumAddressPrefix)
+		require.Nil(t, config.Extra)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, data := range []string{
+			`{"SCORUM_CASHOUT_WINDOW_SECONDS": "2h"}`,
+			`{"SCORUM_CASHOUT_WINDOW_SECONDS": {"count": 7200}}`,
+			`{"SCORUM_OWNER_AUTH_RECOVERY_PERIOD": "9223372036854775807"}`,
+			`{"SCORUM_MIN_ACCOUNT_CREATION_FEE": "1 BTC"}`,
+			`{"VIRTUAL_SCHEDULE_LAP_LENGTH": "1.5"}`,
+			`{"IS_TEST_NET": "maybe"}`,
+			`[]`,
+		} {
+			var config Config
+			require.Error(t, json.Unmarshal([]byte(data), &config), data)
+		}
+	})
+}
+
+func TestAccount_UnmarshalJSON(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		data := `{
+			"name": "alice",
+			"memo_key": "SCR5jPZF7PMgTpLqkdfpMu8kXea8Gio6E646aYpTgcjr9qMLrAgnL",
+			"balance": "1.500000000 SCR",
+			"vesting_shares": "100.000000000 SP",
+			"witnesses_voted_for": "2",
+			"average_bandwidth": "123456789012345678901234",
+			"lifetime_bandwidth": 42,
+			"proxied_vsf_votes": [0, "18446744073709551616", 3, 0],
+			"last_vote_time": "2018-05-01T00:00:00",
+			"scorumpower_paid": "1.000000000 SP"
+		}`
+
+		var account Account
+		require.NoError(t, json.Unmarshal([]byte(data), &account))
+		require.Equal(t, "alice", account.Name)
+		require.Equal(t, "SCR5jPZF7PMgTpLqkdfpMu8kXea8Gio6E646aYpTgcjr9qMLrAgnL", account.MemoKey.String())
+		require.Equal(t, mustAsset(t, "1.500000000 SCR"), account.Balance)
+		require.Equal(t, mustAsset(t, "100.000000000 SP"), account.VestingShares)
+		require.EqualValues(t, 2, account.WitnessesVotedFor)
+		require.Equal(t, "123456789012345678901234", account.AverageBandwidth.String())
+		require.EqualValues(t, 42, account.LifetimeBandwidth.Int64())
+		require.Len(t, account.ProxiedVsfVotes, 4)
+		require.Equal(t, "18446744073709551616", account.ProxiedVsfVotes[1].String())
+		require.Contains(t, account.Extra, "scorumpower_paid")
+	})
+
+	t.Run("null memo key", func(t *testing.T) {
+		for _, memo := range []string{`""`, `null`, `"SCR1111111111111111111111111111111114T1Anm"`} {
+			var account Account
+			require.NoError(t, json.Unmarshal([]byte(`{"memo_key": `+memo+`}`), &account), memo)
+			require.Nil(t, account.MemoKey, memo)
+		}
+	})
+
+	t.Run("invalid memo key", func(t *testing.T) {
+		var account Account
+		require.Error(t, json.Unmarshal([]byte(`{"memo_key": "SCR5jPZF7PMgTpLqkdfpMu8kXea8Gio6E646aYpTgcjr9qMLrAgnM"}`), &account))
+	})
+}
+
+func TestAccount_MarshalJSON(t *testing.T) {
+	data := `{
+		"name": "alice",
+		"memo_key": "SCR5jPZF7PMgTpLqkdfpMu8kXea8Gio6E646aYpTgcjr9qMLrAgnL",
+		"balance": "1.500000000 SCR",
+		"average_bandwidth": 7,
+		"proxied_vsf_votes": ["1", 2],
+		"created": "2018-05-01T00:00:00",
+		"z_field": {"a": 1},
+		"a_field": [1, 2]
+	}`
+
+	var account Account
+	require.NoError(t, json.Unmarshal([]byte(data), &account))
+
+	encoded, err := json.Marshal(account)
+	require.NoError(t, err)
+
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(encoded, &fields))
+	require.JSONEq(t, `"SCR5jPZF7PMgTpLqkdfpMu8kXea8Gio6E646aYpTgcjr9qMLrAgnL"`, string(fields["memo_key"]))
+	require.JSONEq(t, `"1.500000000 SCR"`, string(fields["balance"]))
+	require.JSONEq(t, `"7"`, string(fields["average_bandwidth"]))
+	require.JSONEq(t, `["1", "2"]`, string(fields["proxied_vsf_votes"]))
+	require.JSONEq(t, `"2018-05-01T00:00:00"`, string(fields["created"]))
+	require.JSONEq(t, `null`, string(fields["last_vote_time"]))
+	require.JSONEq(t, `{"a": 1}`, string(fields["z_field"]))
+	require.JSONEq(t, `[1, 2]`, string(fields["a_field"]))
+
+	var decoded Account
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	reencoded, err := json.Marshal(&decoded)
+	require.NoError(t, err)
+	require.Equal(t, string(encoded), string(reencoded))
+}
+
+func TestConfig_MarshalJSON(t *testing.T) {
+	config := Config{
+		ScorumCashoutWindowSeconds:    2 * time.Hour,
+		ScorumOwnerAuthRecoveryPeriod: 30 * 24 * time.Hour,
+	}
+
+	encoded, err := json.Marshal(config)
+	require.NoError(t, err)
+
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(encoded, &fields))
+	require.Equal(t, "7200", string(fields["SCORUM_CASHOUT_WINDOW_SECONDS"]))
+	require.Equal(t, "2592000000000", string(fields["SCORUM_OWNER_AUTH_RECOVERY_PERIOD"]))
+
+	var decoded Config
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	require.Equal(t, config.ScorumCashoutWindowSeconds, decoded.ScorumCashoutWindowSeconds)
+	require.Equal(t, config.ScorumOwnerAuthRecoveryPeriod, decoded.ScorumOwnerAuthRecoveryPeriod)
+}
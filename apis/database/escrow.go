@@ -0,0 +1,128 @@
+package database
+
+import (
+	"errors"
+	"time"
+
+	"github.com/scorum/scorum-go/types"
+)
+
+var (
+	ErrEscrowNotParty             = errors.New("escrow: account is not allowed to perform the step")
+	ErrEscrowAlreadyApproved      = errors.New("escrow: already approved")
+	ErrEscrowNotApproved          = errors.New("escrow: not approved by the receiver and the agent yet")
+	ErrEscrowRatificationExpired  = errors.New("escrow: ratification deadline has passed")
+	ErrEscrowExpired              = errors.New("escrow: escrow has expired")
+	ErrEscrowAlreadyDisputed      = errors.New("escrow: already disputed")
+	ErrEscrowInvalidReceiver      = errors.New("escrow: invalid receiver")
+	ErrEscrowInsufficientBalance  = errors.New("escrow: amount exceeds escrow balance")
+	ErrEscrowReleaseBeforeExpired = errors.New("escrow: funds can be released only to the counterparty before expiration")
+)
+
+type Escrow struct {
+	ID                   uint32      `json:"id"`
+	EscrowID             uint32      `json:"escrow_id"`
+	From                 string      `json:"from"`
+	To                   string      `json:"to"`
+	Agent                string      `json:"agent"`
+	RatificationDeadline types.Time  `json:"ratification_deadline"`
+	EscrowExpiration     types.Time  `json:"escrow_expiration"`
+	ScorumBalance        types.Asset `json:"scorum_balance"`
+	PendingFee           types.Asset `json:"pending_fee"`
+	ToApproved           bool        `json:"to_approved"`
+	AgentApproved        bool        `json:"agent_approved"`
+	Disputed             bool        `json:"disputed"`
+}
+
+// IsApproved reports whether both the receiver and the agent have approved the escrow.
+func (e *Escrow) IsApproved() bool {
+	return e.ToApproved && e.AgentApproved
+}
+
+// CanApprove checks whether who may send escrow_approve at the given chain time.
+func (e *Escrow) CanApprove(who string, now time.Time) error {
+	switch who {
+	case e.To:
+		if e.ToApproved {
+			return ErrEscrowAlreadyApproved
+		}
+	case e.Agent:
+		if e.AgentApproved {
+			return ErrEscrowAlreadyApproved
+		}
+	default:
+		return ErrEscrowNotParty
+	}
+
+	if now.After(e.RatificationDeadline.Time) {
+		return ErrEscrowRatificationExpired
+	}
+
+	return nil
+}
+
+// CanDispute checks whether who may send escrow_dispute at the given chain time.
+func (e *Escrow) CanDispute(who string, now time.Time) error {
+	if who != e.From && who != e.To {
+		return ErrEscrowNotParty
+	}
+
+	if !e.IsApproved() {
+		return ErrEscrowNotApproved
+	}
+
+	if e.Disputed {
+		return ErrEscrowAlreadyDisputed
+	}
+
+	// the chain lets dispute only while escrow_expiration > now
+	if !now.Before(e.EscrowExpiration.Time) {
+		return ErrEscrowExpired
+	}
+
+	return nil
+}
+
+// CanRelease checks whether who may send escrow_release of the amount to the receiver at the given chain time.
+//
+// A disputed escrow is released by the agent only. Otherwise, before the expiration the sender may
+// release the funds to the receiver and vice versa, after the expiration both may release them to anyone of the two.
+func (e *Escrow) CanRelease(who, receiver string, amount types.Asset, now time.Time) error {
+	if who != e.From && who != e.To && who != e.Agent {
+		return ErrEscrowNotParty
+	}
+
+	if receiver != e.From && receiver != e.To {
+		return ErrEscrowInvalidReceiver
+	}
+
+	if !e.IsApproved() {
+		return ErrEscrowNotApproved
+	}
+
+	if amount.Decimal().GreaterThan(e.ScorumBalance.Decimal()) {
+		return ErrEscrowInsufficientBalance
+	}
+
+	if e.Disputed {
+		if who != e.Agent {
+			return ErrEscrowNotParty
+		}
+		return nil
+	}
+
+	if who == e.Agent {
+		return ErrEscrowNotParty
+	}
+
+	// the escrow is expired at the expiration time already
+	if !now.Before(e.EscrowExpiration.Time) {
+		return nil
+	}
+
+	if (who == e.From && receiver != e.To) || (who == e.To && receiver != e.From) {
+		return ErrEscrowReleaseBeforeExpired
+	}
+
+	return nil
+}
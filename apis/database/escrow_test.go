@@ -0,0 +1,86 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/scorum/scorum-go/types"
+)
+
+func newTestEscrow() *Escrow {
+	ratification := time.Date(2018, 5, 1, 0, 0, 0, 0, time.UTC)
+	expiration := time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	return &Escrow{
+		EscrowID:             1,
+		From:                 "alice",
+		To:                   "bob",
+		Agent:                "sam",
+		RatificationDeadline: types.NewTime(ratification),
+		EscrowExpiration:     types.NewTime(expiration),
+		ScorumBalance:        *types.AssetFromFloat(10),
+	}
+}
+
+func TestEscrow_CanApprove(t *testing.T) {
+	before := time.Date(2018, 4, 1, 0, 0, 0, 0, time.UTC)
+	after := time.Date(2018, 5, 2, 0, 0, 0, 0, time.UTC)
+
+	e := newTestEscrow()
+	require.NoError(t, e.CanApprove("bob", before))
+	require.NoError(t, e.CanApprove("sam", before))
+	require.Equal(t, ErrEscrowNotParty, e.CanApprove("alice", before))
+	require.Equal(t, ErrEscrowRatificationExpired, e.CanApprove("bob", after))
+
+	e.ToApproved = true
+	require.Equal(t, ErrEscrowAlreadyApproved, e.CanApprove("bob", before))
+}
+
+func TestEscrow_CanDispute(t *testing.T) {
+	now := time.Date(2018, 5, 10, 0, 0, 0, 0, time.UTC)
+	expired := time.Date(2018, 6, 2, 0, 0, 0, 0, time.UTC)
+
+	e := newTestEscrow()
+	require.Equal(t, ErrEscrowNotApproved, e.CanDispute("alice", now))
+
+	e.ToApproved, e.AgentApproved = true, true
+	require.NoError(t, e.CanDispute("alice", now))
+	require.NoError(t, e.CanDispute("bob", now))
+	require.Equal(t, ErrEscrowNotParty, e.CanDispute("sam", now))
+	require.Equal(t, ErrEscrowExpired, e.CanDispute("alice", expired))
+
+	expiration := e.EscrowExpiration.Time
+	require.NoError(t, e.CanDispute("alice", expiration.Add(-time.Second)))
+	require.Equal(t, ErrEscrowExpired, e.CanDispute("alice", expiration))
+
+	e.Disputed = true
+	require.Equal(t, ErrEscrowAlreadyDisputed, e.CanDispute("alice", now))
+}
+
+func TestEscrow_CanRelease(t *testing.T) {
+	now := time.Date(2018, 5, 10, 0, 0, 0, 0, time.UTC)
+	expired := time.Date(2018, 6, 2, 0, 0, 0, 0, time.UTC)
+	amount := *types.AssetFromFloat(5)
+
+	e := newTestEscrow()
+	require.Equal(t, ErrEscrowNotApproved, e.CanRelease("alice", "bob", amount, now))
+
+	e.ToApproved, e.AgentApproved = true, true
+	require.NoError(t, e.CanRelease("alice", "bob", amount, now))
+	require.NoError(t, e.CanRelease("bob", "alice", amount, now))
+	require.Equal(t, ErrEscrowReleaseBeforeExpired, e.CanRelease("alice", "alice", amount, now))
+	require.Equal(t, ErrEscrowNotParty, e.CanRelease("sam", "bob", amount, now))
+	require.Equal(t, ErrEscrowInvalidReceiver, e.CanRelease("alice", "sam", amount, now))
+	require.Equal(t, ErrEscrowInsufficientBalance, e.CanRelease("alice", "bob", *types.AssetFromFloat(11), now))
+	require.NoError(t, e.CanRelease("alice", "alice", amount, expired))
+
+	expiration := e.EscrowExpiration.Time
+	require.Equal(t, ErrEscrowReleaseBeforeExpired, e.CanRelease("alice", "alice", amount, expiration.Add(-time.Second)))
+	require.NoError(t, e.CanRelease("alice", "alice", amount, expiration))
+
+	e.Disputed = true
+	require.NoError(t, e.CanRelease("sam", "alice", amount, now))
+	require.Equal(t, ErrEscrowNotParty, e.CanRelease("alice", "bob", amount, now))
+}
@@ -0,0 +1,372 @@
+package database
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/scorum/scorum-go/key"
+	"github.com/scorum/scorum-go/types"
+)
+
+// The node objects are decoded leniently as their JSON differs between the node versions:
+// fc serializes the 64-bit numbers which don't fit 32 bits as strings, the fields are added, dropped and retyped.
+//   - the numbers, durations, big numbers and assets may be given either as JSON numbers or as strings
+//   - null and missing fields are left as they are
+//   - the fields unknown to the model are kept raw, so they are still accessible and encoded back
+// The durations are given in seconds unless the field is tagged with unit:"us" for microseconds.
+
+// nullPublicKey is the key the chain uses for "no key", it isn't a valid curve point.
+const nullPublicKey = "SCR1111111111111111111111111111111114T1Anm"
+
+var (
+	durationType  = reflect.TypeOf(time.Duration(0))
+	bigIntType    = reflect.TypeOf((*big.Int)(nil))
+	assetType     = reflect.TypeOf(types.Asset{})
+	publicKeyType = reflect.TypeOf((*key.PublicKey)(nil))
+
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+	errNotScalar = errors.New("expecting a number or a string")
+)
+
+// unmarshalLenient decodes the JSON object into the struct pointed by v, the unknown fields are stored to extra.
+func unmarshalLenient(data []byte, v interface{}, extra *map[string]json.RawMessage) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	rv := reflect.ValueOf(v).Elem()
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		name := jsonName(rt.Field(i))
+		if name == "" {
+			continue
+		}
+
+		raw, ok := fields[name]
+		if !ok {
+			continue
+		}
+		delete(fields, name)
+
+		if err := decodeLenient(rv.Field(i), raw, rt.Field(i).Tag.Get("unit")); err != nil {
+			return fmt.Errorf("decode %s: %w", name, err)
+		}
+	}
+
+	*extra = nil
+	if len(fields) > 0 {
+		*extra = fields
+	}
+	return nil
+}
+
+// marshalLenient encodes the struct the way unmarshalLenient reads it back,
+// the known fields go in the order of declaration followed by the extra fields sorted by name.
+func marshalLenient(v interface{}, extra map[string]json.RawMessage) ([]byte, error) {
+	// the copy is addressable, so the fields with pointer receiver marshallers are encoded properly
+	rv := reflect.New(reflect.TypeOf(v)).Elem()
+	rv.Set(reflect.ValueOf(v))
+	rt := rv.Type()
+
+	var (
+		buf   bytes.Buffer
+		known = make(map[string]bool, rt.NumField())
+	)
+
+	buf.WriteByte('{')
+	for i := 0; i < rt.NumField(); i++ {
+		name := jsonName(rt.Field(i))
+		if name == "" {
+			continue
+		}
+		known[name] = true
+
+		value, err := encodeLenient(rv.Field(i), rt.Field(i).Tag.Get("unit"))
+		if err != nil {
+			return nil, fmt.Errorf("encode %s: %w", name, err)
+		}
+		writeMember(&buf, name, value)
+	}
+
+	names := make([]string, 0, len(extra))
+	for name := range extra {
+		if !known[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		writeMember(&buf, name, extra[name])
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+func writeMember(buf *bytes.Buffer, name string, value []byte) {
+	if buf.Len() > 1 {
+		buf.WriteByte(',')
+	}
+	buf.WriteString(strconv.Quote(name))
+	buf.WriteByte(':')
+	buf.Write(value)
+}
+
+// jsonName returns the JSON name of the field or an empty string if the field isn't encoded.
+func jsonName(f reflect.StructField) string {
+	if f.PkgPath != "" {
+		return ""
+	}
+
+	name := strings.Split(f.Tag.Get("json"), ",")[0]
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return f.Name
+	}
+	return name
+}
+
+func decodeLenient(v reflect.Value, raw json.RawMessage, unit string) error {
+	if string(raw) == "null" {
+		return nil
+	}
+
+	switch v.Type() {
+	case durationType:
+		s, err := scalar(raw)
+		if err != nil {
+			return err
+		}
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return err
+		}
+		d, err := durationOf(n, unit)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+
+	case bigIntType:
+		s, err := scalar(raw)
+		if err != nil {
+			return err
+		}
+		n, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			return fmt.Errorf("invalid number %s", raw)
+		}
+		v.Set(reflect.ValueOf(n))
+		return nil
+
+	case assetType:
+		s, err := scalar(raw)
+		if err != nil {
+			return err
+		}
+		var asset types.Asset
+		if err := asset.UnmarshalText([]byte(s)); err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(asset))
+		return nil
+
+	case publicKeyType:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return err
+		}
+		if s == "" || s == nullPublicKey {
+			v.Set(reflect.Zero(publicKeyType))
+			return nil
+		}
+		pub, err := key.NewPublicKey(s)
+		if err != nil {
+			return fmt.Errorf("invalid public key %s: %w", s, err)
+		}
+		v.Set(reflect.ValueOf(pub))
+		return nil
+	}
+
+	if ptr := reflect.PtrTo(v.Type()); ptr.Implements(jsonUnmarshalerType) || ptr.Implements(textUnmarshalerType) {
+		return json.Unmarshal(raw, v.Addr().Interface())
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		s, err := scalar(raw)
+		if err != nil {
+			return err
+		}
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+		return nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		s, err := scalar(raw)
+		if err != nil {
+			return err
+		}
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+		return nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		s, err := scalar(raw)
+		if err != nil {
+			return err
+		}
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+		return nil
+
+	case reflect.String:
+		s, err := scalar(raw)
+		if err != nil {
+			return err
+		}
+		v.SetString(s)
+		return nil
+
+	case reflect.Slice:
+		if !isLenient(v.Type().Elem()) {
+			break
+		}
+
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return err
+		}
+
+		slice := reflect.MakeSlice(v.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := decodeLenient(slice.Index(i), item, unit); err != nil {
+				return fmt.Errorf("item %d: %w", i, err)
+			}
+		}
+		v.Set(slice)
+		return nil
+	}
+
+	return json.Unmarshal(raw, v.Addr().Interface())
+}
+
+func encodeLenient(v reflect.Value, unit string) ([]byte, error) {
+	switch v.Type() {
+	case durationType:
+		d, err := unitOf(unit)
+		if err != nil {
+			return nil, err
+		}
+		return strconv.AppendInt(nil, v.Int()/int64(d), 10), nil
+
+	case bigIntType:
+		if v.IsNil() {
+			return []byte("null"), nil
+		}
+		// uint128 values don't fit the JSON numbers, they are strings like fc does
+		return []byte(strconv.Quote(v.Interface().(*big.Int).String())), nil
+
+	case publicKeyType:
+		if v.IsNil() {
+			return []byte("null"), nil
+		}
+		return []byte(strconv.Quote(v.Interface().(*key.PublicKey).String())), nil
+	}
+
+	if v.Kind() == reflect.Slice && isLenient(v.Type().Elem()) {
+		if v.IsNil() {
+			return []byte("null"), nil
+		}
+
+		buf := []byte{'['}
+		for i := 0; i < v.Len(); i++ {
+			if i > 0 {
+				buf = append(buf, ',')
+			}
+			item, err := encodeLenient(v.Index(i), unit)
+			if err != nil {
+				return nil, err
+			}
+			buf = append(buf, item...)
+		}
+		return append(buf, ']'), nil
+	}
+
+	return json.Marshal(v.Addr().Interface())
+}
+
+// isLenient reports whether the values of the type are decoded by decodeLenient rather than encoding/json.
+func isLenient(t reflect.Type) bool {
+	switch t {
+	case durationType, bigIntType, assetType, publicKeyType:
+		return true
+	}
+	return false
+}
+
+// scalar returns the text of the JSON number, boolean or string.
+func scalar(raw json.RawMessage) (string, error) {
+	if len(raw) == 0 {
+		return "", errNotScalar
+	}
+
+	switch raw[0] {
+	case '"':
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return "", err
+		}
+		return s, nil
+	case '{', '[':
+		return "", fmt.Errorf("%w, got %s", errNotScalar, raw)
+	}
+	return string(raw), nil
+}
+
+func unitOf(unit string) (time.Duration, error) {
+	switch unit {
+	case "", "s":
+		return time.Second, nil
+	case "us":
+		return time.Microsecond, nil
+	}
+	return 0, fmt.Errorf("unknown duration unit %q", unit)
+}
+
+func durationOf(n int64, unit string) (time.Duration, error) {
+	d, err := unitOf(unit)
+	if err != nil {
+		return 0, err
+	}
+	if n > math.MaxInt64/int64(d) || n < math.MinInt64/int64(d) {
+		return 0, fmt.Errorf("duration %d%s overflows", n, unit)
+	}
+	return time.Duration(n) * d, nil
+}
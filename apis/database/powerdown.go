@@ -0,0 +1,89 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/scorum/scorum-go/types"
+)
+
+// PowerDownPayout is a single scheduled scorumpower withdrawal.
+type PowerDownPayout struct {
+	Time   time.Time
+	Amount types.Asset
+}
+
+// PowerDownSchedule lists every future scorumpower withdrawal of the account, starting from NextVestingWithdrawal.
+// It follows the chain: every SCORUM_VESTING_WITHDRAW_INTERVAL_SECONDS the VestingWithdrawRate is withdrawn
+// until ToWithdraw is withdrawn or the account scorumpower is exhausted, the final payout is the remainder
+// ToWithdraw % VestingWithdrawRate. The payouts already made are counted in Withdrawn.
+// Like the chain, a payout is capped by VestingShares only, the delegated scorumpower isn't subtracted.
+//
+// SCORUM_VESTING_WITHDRAW_INTERVALS is not used: the chain applies it only once, to set the rate
+// to ToWithdraw / SCORUM_VESTING_WITHDRAW_INTERVALS when the power down starts, then it pays out the rate
+// until ToWithdraw is reached. So the remainder makes one payout more than the intervals
+// and the rate of a power down started before the constant was changed doesn't match it.
+//
+// It returns an empty schedule if there is no power down in progress.
+func (a *Account) PowerDownSchedule(config *Config) ([]PowerDownPayout, error) {
+	rate := a.VestingWithdrawRate
+	if !rate.IsPositive() || !a.ToWithdraw.IsPositive() || !a.NextVestingWithdrawal.IsSet() {
+		return nil, nil
+	}
+
+	left, err := a.ToWithdraw.Sub(a.Withdrawn)
+	if err != nil {
+		return nil, fmt.Errorf("subtract withdrawn: %w", err)
+	}
+
+	if _, err := rate.Cmp(left); err != nil {
+		return nil, fmt.Errorf("compare vesting withdraw rate: %w", err)
+	}
+	remainder := *types.NewAsset(a.ToWithdraw.Amount()%rate.Amount(), rate.Precision(), rate.Symbol())
+
+	available := a.VestingShares
+
+	var (
+		schedule []PowerDownPayout
+		next     = a.NextVestingWithdrawal.Time
+	)
+
+	for left.IsPositive() && available.IsPositive() {
+		amount := rate
+		if cmp, _ := left.Cmp(rate); cmp < 0 {
+			amount = remainder
+		}
+		if amount, err = minAsset(amount, available); err != nil {
+			return nil, fmt.Errorf("compare available scorumpower: %w", err)
+		}
+		if !amount.IsPositive() {
+			break
+		}
+
+		schedule = append(schedule, PowerDownPayout{
+			Time:   next,
+			Amount: amount,
+		})
+
+		if left, err = left.Sub(amount); err != nil {
+			return nil, fmt.Errorf("subtract payout: %w", err)
+		}
+		if available, err = available.Sub(amount); err != nil {
+			return nil, fmt.Errorf("subtract payout: %w", err)
+		}
+		next = next.Add(config.ScorumVestingWithdrawIntervalSeconds)
+	}
+
+	return schedule, nil
+}
+
+func minAsset(a, b types.Asset) (types.Asset, error) {
+	cmp, err := a.Cmp(b)
+	if err != nil {
+		return types.Asset{}, err
+	}
+	if cmp > 0 {
+		return b, nil
+	}
+	return a, nil
+}
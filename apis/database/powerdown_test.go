@@ -0,0 +1,121 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/scorum/scorum-go/types"
+)
+
+func TestAccount_PowerDownSchedule(t *testing.T) {
+	config := &Config{
+		ScorumVestingWithdrawIntervals:       4,
+		ScorumVestingWithdrawIntervalSeconds: 7 * 24 * time.Hour,
+	}
+
+	next := time.Date(2018, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("limited by to withdraw", func(t *testing.T) {
+		account := Account{
+			VestingShares:          mustAsset(t, "100.000000000 SP"),
+			DelegatedVestingShares: mustAsset(t, "0.000000000 SP"),
+			VestingWithdrawRate:    mustAsset(t, "10.000000000 SP"),
+			NextVestingWithdrawal:  types.NewTime(next),
+			ToWithdraw:             mustAsset(t, "40.000000000 SP"),
+			Withdrawn:              mustAsset(t, "0.000000000 SP"),
+		}
+
+		schedule, err := account.PowerDownSchedule(config)
+		require.NoError(t, err)
+		require.Len(t, schedule, 4)
+		require.Equal(t, next, schedule[0].Time)
+		require.Equal(t, next.Add(3*7*24*time.Hour), schedule[3].Time)
+		require.Equal(t, "10.000000000 SP", schedule[3].Amount.String())
+	})
+
+	t.Run("limited by scorumpower", func(t *testing.T) {
+		// the delegated scorumpower is withdrawn too
+		account := Account{
+			VestingShares:          mustAsset(t, "25.000000000 SP"),
+			DelegatedVestingShares: mustAsset(t, "5.000000000 SP"),
+			VestingWithdrawRate:    mustAsset(t, "10.000000000 SP"),
+			NextVestingWithdrawal:  types.NewTime(next),
+			ToWithdraw:             mustAsset(t, "40.000000000 SP"),
+			Withdrawn:              mustAsset(t, "0.000000000 SP"),
+		}
+
+		schedule, err := account.PowerDownSchedule(config)
+		require.NoError(t, err)
+		require.Len(t, schedule, 3)
+		require.Equal(t, "10.000000000 SP", schedule[1].Amount.String())
+		require.Equal(t, "5.000000000 SP", schedule[2].Amount.String())
+	})
+
+	t.Run("in progress", func(t *testing.T) {
+		// 10.000000003 SP withdrawn in 4 intervals: the rate is 2.5 SP and the fifth payout is the remainder
+		account := Account{
+			VestingShares:         mustAsset(t, "7.500000003 SP"),
+			VestingWithdrawRate:   mustAsset(t, "2.500000000 SP"),
+			NextVestingWithdrawal: types.NewTime(next),
+			ToWithdraw:            mustAsset(t, "10.000000003 SP"),
+			Withdrawn:             mustAsset(t, "5.000000000 SP"),
+		}
+
+		schedule, err := account.PowerDownSchedule(config)
+		require.NoError(t, err)
+		require.Len(t, schedule, 3)
+		require.Equal(t, next, schedule[0].Time)
+		require.Equal(t, "2.500000000 SP", schedule[0].Amount.String())
+		require.Equal(t, "2.500000000 SP", schedule[1].Amount.String())
+		require.Equal(t, next.Add(2*7*24*time.Hour), schedule[2].Time)
+		require.Equal(t, "0.000000003 SP", schedule[2].Amount.String())
+	})
+
+	t.Run("last payout", func(t *testing.T) {
+		account := Account{
+			VestingShares:         mustAsset(t, "100.000000000 SP"),
+			VestingWithdrawRate:   mustAsset(t, "2.500000000 SP"),
+			NextVestingWithdrawal: types.NewTime(next),
+			ToWithdraw:            mustAsset(t, "10.000000000 SP"),
+			Withdrawn:             mustAsset(t, "7.500000000 SP"),
+		}
+
+		schedule, err := account.PowerDownSchedule(config)
+		require.NoError(t, err)
+		require.Len(t, schedule, 1)
+		require.Equal(t, "2.500000000 SP", schedule[0].Amount.String())
+	})
+
+	t.Run("no power down", func(t *testing.T) {
+		account := Account{
+			VestingShares:       mustAsset(t, "30.000000000 SP"),
+			VestingWithdrawRate: mustAsset(t, "0.000000000 SP"),
+			ToWithdraw:          mustAsset(t, "40.000000000 SP"),
+		}
+
+		schedule, err := account.PowerDownSchedule(config)
+		require.NoError(t, err)
+		require.Empty(t, schedule)
+
+		account.VestingWithdrawRate = mustAsset(t, "10.000000000 SP")
+		account.NextVestingWithdrawal = types.Never
+
+		schedule, err = account.PowerDownSchedule(config)
+		require.NoError(t, err)
+		require.Empty(t, schedule)
+	})
+
+	t.Run("symbols mismatch", func(t *testing.T) {
+		account := Account{
+			VestingShares:         mustAsset(t, "30.000000000 SP"),
+			VestingWithdrawRate:   mustAsset(t, "10.000000000 SCR"),
+			NextVestingWithdrawal: types.NewTime(next),
+			ToWithdraw:            mustAsset(t, "40.000000000 SP"),
+		}
+
+		_, err := account.PowerDownSchedule(config)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,105 @@
+package network_broadcast
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/scorum/scorum-go/caller"
+	"github.com/scorum/scorum-go/types"
+)
+
+// expirationGracePeriod is how long to wait for the notice after the transaction expiration,
+// the node reports expired transactions only when it applies the next block.
+const expirationGracePeriod = 10 * time.Second
+
+var (
+	ErrCallbackNotSupported = errors.New("transport does not support callbacks")
+	ErrBroadcastTimeout     = errors.New("broadcast notice is not received before the transaction expiration")
+)
+
+// BroadcastFuture is resolved as soon as the node reports the transaction inclusion or expiration.
+type BroadcastFuture struct {
+	once sync.Once
+	done chan struct{}
+	resp *BroadcastResponse
+	err  error
+}
+
+func newBroadcastFuture() *BroadcastFuture {
+	return &BroadcastFuture{done: make(chan struct{})}
+}
+
+func (f *BroadcastFuture) resolve(resp *BroadcastResponse, err error) {
+	f.once.Do(func() {
+		f.resp = resp
+		f.err = err
+		close(f.done)
+	})
+}
+
+// Done is closed when the future is resolved.
+func (f *BroadcastFuture) Done() <-chan struct{} {
+	return f.done
+}
+
+// Wait blocks until the future is resolved and returns the broadcast result.
+func (f *BroadcastFuture) Wait() (*BroadcastResponse, error) {
+	<-f.done
+	return f.resp, f.err
+}
+
+// BroadcastTransactionWithCallback broadcasts the transaction and returns immediately, the returned future is resolved
+// when the node notifies about the transaction inclusion or expiration. The future fails with ErrBroadcastTimeout
+// if there is no notice shortly after the transaction expiration or with ctx.Err() if the context is done first.
+// It requires a transport supporting callbacks, i.e. websocket.
+func (api *API) BroadcastTransactionWithCallback(ctx context.Context, tx *types.Transaction) (*BroadcastFuture, error) {
+	cc, ok := api.caller.(caller.CallbackCaller)
+	if !ok {
+		return nil, ErrCallbackNotSupported
+	}
+
+	future := newBroadcastFuture()
+	cancel, err := cc.CallWithCallback(ctx, APIID, "broadcast_transaction_with_callback", []interface{}{tx}, func(raw json.RawMessage) {
+		var resp []BroadcastResponse
+		if err := json.Unmarshal(raw, &resp); err != nil {
+			future.resolve(nil, fmt.Errorf("json unmarshal broadcast notice: %w", err))
+			return
+		}
+
+		if len(resp) == 0 {
+			future.resolve(nil, errors.New("empty broadcast notice"))
+			return
+		}
+
+		future.resolve(&resp[0], nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := expirationGracePeriod
+	if !tx.Expiration.IsZero() {
+		timeout += time.Until(tx.Expiration.Time)
+	}
+
+	go func() {
+		defer cancel()
+
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+
+		select {
+		case <-future.Done():
+		case <-ctx.Done():
+			future.resolve(nil, ctx.Err())
+		case <-timer.C:
+			future.resolve(nil, ErrBroadcastTimeout)
+		}
+	}()
+
+	return future, nil
+}
@@ -0,0 +1,92 @@
+package network_broadcast
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/scorum/scorum-go/types"
+)
+
+type callbackCaller struct {
+	callback func(raw json.RawMessage)
+	method   string
+	args     []interface{}
+}
+
+func (c *callbackCaller) Call(ctx context.Context, api string, method string, args []interface{}, reply interface{}) error {
+	return nil
+}
+
+func (c *callbackCaller) SetCallback(api string, method string, callback func(raw json.RawMessage)) error {
+	panic("not supported")
+}
+
+func (c *callbackCaller) CallWithCallback(ctx context.Context, api string, method string, args []interface{}, callback func(raw json.RawMessage)) (func(), error) {
+	c.method = method
+	c.args = args
+	c.callback = callback
+	return func() {}, nil
+}
+
+type noCallbackCaller struct{}
+
+func (c noCallbackCaller) Call(ctx context.Context, api string, method string, args []interface{}, reply interface{}) error {
+	return nil
+}
+
+func (c noCallbackCaller) SetCallback(api string, method string, callback func(raw json.RawMessage)) error {
+	panic("not supported")
+}
+
+func newTransaction(expiration time.Time) *types.Transaction {
+	return &types.Transaction{Expiration: types.NewTime(expiration)}
+}
+
+func TestBroadcastTransactionWithCallback(t *testing.T) {
+	cc := &callbackCaller{}
+	api := NewAPI(cc)
+
+	future, err := api.BroadcastTransactionWithCallback(context.Background(), newTransaction(time.Now().Add(time.Minute)))
+	require.NoError(t, err)
+	require.Equal(t, "broadcast_transaction_with_callback", cc.method)
+	require.Len(t, cc.args, 1)
+
+	cc.callback(json.RawMessage(`[{"id":"abc","block_num":10,"trx_num":1,"expired":false}]`))
+
+	resp, err := future.Wait()
+	require.NoError(t, err)
+	require.Equal(t, &BroadcastResponse{ID: "abc", BlockNum: 10, TrxNum: 1}, resp)
+}
+
+func TestBroadcastTransactionWithCallback_Timeout(t *testing.T) {
+	api := NewAPI(&callbackCaller{})
+
+	future, err := api.BroadcastTransactionWithCallback(context.Background(), newTransaction(time.Now().Add(-expirationGracePeriod)))
+	require.NoError(t, err)
+
+	_, err = future.Wait()
+	require.Equal(t, ErrBroadcastTimeout, err)
+}
+
+func TestBroadcastTransactionWithCallback_ContextCanceled(t *testing.T) {
+	api := NewAPI(&callbackCaller{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	future, err := api.BroadcastTransactionWithCallback(ctx, newTransaction(time.Now().Add(time.Minute)))
+	require.NoError(t, err)
+
+	cancel()
+	_, err = future.Wait()
+	require.Equal(t, context.Canceled, err)
+}
+
+func TestBroadcastTransactionWithCallback_NotSupported(t *testing.T) {
+	api := NewAPI(noCallbackCaller{})
+
+	_, err := api.BroadcastTransactionWithCallback(context.Background(), newTransaction(time.Now()))
+	require.Equal(t, ErrCallbackNotSupported, err)
+}
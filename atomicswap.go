@@ -0,0 +1,218 @@
+package scorumgo
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/scorum/scorum-go/key"
+	"github.com/scorum/scorum-go/rpc/protocol"
+	"github.com/scorum/scorum-go/types"
+)
+
+const atomicSwapSecretLength = 32
+
+// ErrAtomicSwapRefunded is returned by AtomicSwap.Wait when the contract wasn't redeemed
+// before its deadline and the locked amount was refunded to the owner.
+var ErrAtomicSwapRefunded = errors.New("atomic swap refunded")
+
+// NewAtomicSwapSecret generates a random secret and returns it along with its hash, both hex encoded.
+func NewAtomicSwapSecret() (secret string, secretHash string, err error) {
+	b := make([]byte, atomicSwapSecretLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", fmt.Errorf("generate secret: %w", err)
+	}
+
+	secret = hex.EncodeToString(b)
+	secretHash, err = AtomicSwapSecretHash(secret)
+	return secret, secretHash, err
+}
+
+// AtomicSwapSecretHash returns the hex encoded sha256 hash of the hex encoded secret.
+func AtomicSwapSecretHash(secret string) (string, error) {
+	b, err := hex.DecodeString(secret)
+	if err != nil {
+		return "", fmt.Errorf("decode secret: %w", err)
+	}
+
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:]), nil
+}
+
+// AtomicSwap is one side of an atomic swap: the contract Owner -> Recipient locked with SecretHash.
+//
+// The initiator knows the Secret from the very beginning, the participant learns it
+// as soon as the initiator redeems the participant contract.
+type AtomicSwap struct {
+	client  *Client
+	chainID []byte
+	key     *key.PrivateKey
+
+	Owner      string
+	Recipient  string
+	Secret     string
+	SecretHash string
+	// Deadline is the chain time after which the owner may refund the contract.
+	Deadline time.Time
+}
+
+// InitiateAtomicSwap generates a new secret and locks the amount for the recipient.
+// The contract may be refunded after SCORUM_ATOMICSWAP_INITIATOR_REFUND_LOCK_SECS.
+func (client *Client) InitiateAtomicSwap(ctx context.Context, chainID []byte, owner, recipient string, amount types.Asset, metadata string, key *key.PrivateKey) (*AtomicSwap, error) {
+	secret, secretHash, err := NewAtomicSwapSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	swap, err := client.initiateAtomicSwap(ctx, chainID, types.AtomicswapByInitiator, owner, recipient, amount, secretHash, metadata, key)
+	if err != nil {
+		return nil, err
+	}
+	swap.Secret = secret
+
+	return swap, nil
+}
+
+// ParticipateAtomicSwap locks the amount for the recipient (the initiator of the swap)
+// with the secret hash taken from the initiator contract.
+// The contract may be refunded after SCORUM_ATOMICSWAP_PARTICIPANT_REFUND_LOCK_SECS.
+func (client *Client) ParticipateAtomicSwap(ctx context.Context, chainID []byte, owner, recipient string, amount types.Asset, secretHash, metadata string, key *key.PrivateKey) (*AtomicSwap, error) {
+	return client.initiateAtomicSwap(ctx, chainID, types.AtomicswapByParticipant, owner, recipient, amount, secretHash, metadata, key)
+}
+
+func (client *Client) initiateAtomicSwap(ctx context.Context, chainID []byte, kind types.AtomicswapInitiateKind, owner, recipient string, amount types.Asset, secretHash, metadata string, key *key.PrivateKey) (*AtomicSwap, error) {
+	ops := []types.Operation{
+		&types.InitiateAtomicswapOperation{
+			Kind:       kind,
+			Owner:      owner,
+			Recipient:  recipient,
+			Amount:     amount,
+			SecretHash: secretHash,
+			Metadata:   metadata,
+		},
+	}
+
+	if _, err := client.BroadcastTransactionSynchronous(ctx, chainID, ops, key); err != nil {
+		return nil, fmt.Errorf("broadcast atomicswap initiate: %w", err)
+	}
+
+	// the deadline is counted from the time of the block the contract is created in
+	contract, err := client.Database.GetAtomicSwapContract(ctx, owner, recipient, secretHash)
+	if err != nil {
+		return nil, fmt.Errorf("get atomicswap contract: %w", err)
+	}
+
+	return &AtomicSwap{
+		client:     client,
+		chainID:    chainID,
+		key:        key,
+		Owner:      owner,
+		Recipient:  recipient,
+		SecretHash: secretHash,
+		Deadline:   contract.Deadline.Time,
+	}, nil
+}
+
+// Redeem redeems the counterparty contract from -> swap.Owner with the swap secret.
+func (swap *AtomicSwap) Redeem(ctx context.Context, from string) error {
+	if swap.Secret == "" {
+		return errors.New("atomic swap secret is unknown")
+	}
+
+	ops := []types.Operation{
+		&types.RedeemAtomicswapOperation{
+			From:   from,
+			To:     swap.Owner,
+			Secret: swap.Secret,
+		},
+	}
+
+	if _, err := swap.client.BroadcastTransactionSynchronous(ctx, swap.chainID, ops, swap.key); err != nil {
+		return fmt.Errorf("broadcast atomicswap redeem: %w", err)
+	}
+	return nil
+}
+
+// Refund returns the locked amount to the owner, it is allowed only after the Deadline.
+func (swap *AtomicSwap) Refund(ctx context.Context) error {
+	ops := []types.Operation{
+		&types.RefundAtomicswapOperation{
+			Participant: swap.Owner,
+			Initiator:   swap.Recipient,
+			SecretHash:  swap.SecretHash,
+		},
+	}
+
+	if _, err := swap.client.BroadcastTransactionSynchronous(ctx, swap.chainID, ops, swap.key); err != nil {
+		return fmt.Errorf("broadcast atomicswap refund: %w", err)
+	}
+	return nil
+}
+
+// Wait polls the contract every interval until the recipient redeems it and returns the secret the node reveals.
+// In case the contract is still not redeemed when the Deadline is reached, Wait refunds it and returns ErrAtomicSwapRefunded.
+// The refund rejected by the node, e.g. because the block time hasn't reached the deadline yet, is retried every interval.
+func (swap *AtomicSwap) Wait(ctx context.Context, interval time.Duration) (string, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var refundErr error
+	for {
+		secret, err := swap.findRedeemSecret(ctx)
+		if err != nil {
+			return "", err
+		}
+
+		if secret != "" {
+			swap.Secret = secret
+			return secret, nil
+		}
+
+		props, err := swap.client.Chain.GetChainProperties(ctx)
+		if err != nil {
+			return "", fmt.Errorf("get chain properties: %w", err)
+		}
+
+		if !props.Time.Before(swap.Deadline) {
+			refundErr = swap.Refund(ctx)
+			if refundErr == nil {
+				return "", ErrAtomicSwapRefunded
+			}
+
+			var rpcErr *protocol.RPCError
+			if !errors.As(refundErr, &rpcErr) {
+				return "", refundErr
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			if refundErr != nil {
+				return "", fmt.Errorf("%w, the last refund attempt: %v", ctx.Err(), refundErr)
+			}
+			return "", ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (swap *AtomicSwap) findRedeemSecret(ctx context.Context) (string, error) {
+	contract, err := swap.client.Database.GetAtomicSwapContract(ctx, swap.Owner, swap.Recipient, swap.SecretHash)
+	if err != nil {
+		return "", fmt.Errorf("get atomicswap contract: %w", err)
+	}
+
+	if contract.Secret == "" {
+		return "", nil
+	}
+
+	hash, err := AtomicSwapSecretHash(contract.Secret)
+	if err != nil || hash != swap.SecretHash {
+		return "", fmt.Errorf("atomicswap contract is redeemed with the secret %q not matching the secret hash", contract.Secret)
+	}
+	return contract.Secret, nil
+}
@@ -0,0 +1,215 @@
+package scorumgo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/scorum/scorum-go/key"
+	"github.com/scorum/scorum-go/rpc/protocol"
+	"github.com/scorum/scorum-go/sign"
+	"github.com/scorum/scorum-go/types"
+)
+
+func TestNewAtomicSwapSecret(t *testing.T) {
+	secret, secretHash, err := NewAtomicSwapSecret()
+	require.NoError(t, err)
+	require.Len(t, secret, 64)
+
+	hash, err := AtomicSwapSecretHash(secret)
+	require.NoError(t, err)
+	require.Equal(t, secretHash, hash)
+}
+
+func TestAtomicSwapSecretHash(t *testing.T) {
+	hash, err := AtomicSwapSecretHash("00")
+	require.NoError(t, err)
+	require.Equal(t, "6e340b9cffb37a989ca544e6bb780a2c78901d3fb33738768511a30617afa01d", hash)
+
+	_, err = AtomicSwapSecretHash("not hex")
+	require.Error(t, err)
+}
+
+const fakeHeadBlockID = "0000000109833ce528d5bbfb3f6225b39ee10086"
+
+// fakeCaller answers every method with the queued replies: the JSON result or the error.
+// The replies are taken in order, the last one is repeated. The broadcasted transactions are recorded.
+type fakeCaller struct {
+	replies     map[string][]interface{}
+	broadcasted []*types.Transaction
+}
+
+func newFakeCaller() *fakeCaller {
+	return &fakeCaller{replies: make(map[string][]interface{})}
+}
+
+func (c *fakeCaller) reply(method string, replies ...interface{}) {
+	c.replies[method] = append(c.replies[method], replies...)
+}
+
+// replyChainTime queues get_chain_properties of the given chain times.
+func (c *fakeCaller) replyChainTime(times ...string) {
+	for _, t := range times {
+		c.reply("get_chain_properties", fmt.Sprintf(`{"time":%q,"head_block_id":%q}`, t, fakeHeadBlockID))
+	}
+}
+
+func (c *fakeCaller) Call(ctx context.Context, api string, method string, args []interface{}, reply interface{}) error {
+	if method == "broadcast_transaction_synchronous" {
+		c.broadcasted = append(c.broadcasted, args[0].(*types.Transaction))
+	}
+
+	queue := c.replies[method]
+	if len(queue) == 0 {
+		return fmt.Errorf("unexpected call of %s", method)
+	}
+	if len(queue) > 1 {
+		c.replies[method] = queue[1:]
+	}
+
+	switch r := queue[0].(type) {
+	case error:
+		return r
+	case string:
+		return json.Unmarshal([]byte(r), reply)
+	}
+	return fmt.Errorf("invalid reply of %s: %v", method, queue[0])
+}
+
+func (c *fakeCaller) SetCallback(api string, method string, callback func(raw json.RawMessage)) error {
+	return nil
+}
+
+func (c *fakeCaller) Close() error {
+	return nil
+}
+
+func (c *fakeCaller) broadcastedOps() []types.Operation {
+	var ops []types.Operation
+	for _, tx := range c.broadcasted {
+		ops = append(ops, tx.Operations...)
+	}
+	return ops
+}
+
+func newTestAtomicSwap(t *testing.T, cc *fakeCaller, secretHash string) *AtomicSwap {
+	owner, err := key.NewPrivateKey()
+	require.NoError(t, err)
+
+	cc.replyChainTime("2018-08-03T10:00:00")
+	cc.reply("broadcast_transaction_synchronous", `{"id":"1","block_num":1}`)
+	// the contract deadline differs from the chain time the initiate is signed at
+	cc.reply("get_atomicswap_contract", fmt.Sprintf(`{"owner":"alice","to":"bob","deadline":"2018-08-04T10:00:06","secret_hash":%q,"secret":""}`, secretHash))
+
+	client := NewClient(cc, WithHeadBlockReferenceSign())
+	swap, err := client.ParticipateAtomicSwap(context.Background(), sign.TestNetChainID, "alice", "bob", *types.AssetFromFloat(1), secretHash, "", owner)
+	require.NoError(t, err)
+
+	cc.replies = make(map[string][]interface{})
+	cc.broadcasted = nil
+	return swap
+}
+
+func TestClient_ParticipateAtomicSwap(t *testing.T) {
+	cc := newFakeCaller()
+	secret, secretHash, err := NewAtomicSwapSecret()
+	require.NoError(t, err)
+
+	swap := newTestAtomicSwap(t, cc, secretHash)
+	require.Equal(t, time.Date(2018, 8, 4, 10, 0, 6, 0, time.UTC), swap.Deadline)
+	require.Empty(t, swap.Secret)
+
+	require.Error(t, swap.Redeem(context.Background(), "bob"), "the secret is unknown yet")
+
+	swap.Secret = secret
+	cc.replyChainTime("2018-08-03T10:00:00")
+	cc.reply("broadcast_transaction_synchronous", `{}`)
+	require.NoError(t, swap.Redeem(context.Background(), "bob"))
+	require.Equal(t, []types.Operation{
+		&types.RedeemAtomicswapOperation{From: "bob", To: "alice", Secret: secret},
+	}, cc.broadcastedOps())
+}
+
+func TestAtomicSwap_Wait(t *testing.T) {
+	ctx := context.Background()
+	secret, secretHash, err := NewAtomicSwapSecret()
+	require.NoError(t, err)
+
+	t.Run("redeemed", func(t *testing.T) {
+		cc := newFakeCaller()
+		swap := newTestAtomicSwap(t, cc, secretHash)
+
+		cc.replyChainTime("2018-08-03T11:00:00")
+		cc.reply("get_atomicswap_contract",
+			fmt.Sprintf(`{"secret_hash":%q,"secret":""}`, secretHash),
+			fmt.Sprintf(`{"secret_hash":%q,"secret":%q}`, secretHash, secret),
+		)
+
+		got, err := swap.Wait(ctx, time.Millisecond)
+		require.NoError(t, err)
+		require.Equal(t, secret, got)
+		require.Equal(t, secret, swap.Secret)
+		require.Empty(t, cc.broadcasted)
+	})
+
+	t.Run("redeemed with another secret", func(t *testing.T) {
+		cc := newFakeCaller()
+		swap := newTestAtomicSwap(t, cc, secretHash)
+
+		cc.replyChainTime("2018-08-03T11:00:00")
+		cc.reply("get_atomicswap_contract", fmt.Sprintf(`{"secret_hash":%q,"secret":"00"}`, secretHash))
+
+		_, err := swap.Wait(ctx, time.Millisecond)
+		require.Error(t, err)
+	})
+
+	t.Run("refunded", func(t *testing.T) {
+		cc := newFakeCaller()
+		swap := newTestAtomicSwap(t, cc, secretHash)
+
+		// the chain time reaches the deadline, but the node rejects the first refund
+		cc.replyChainTime("2018-08-04T10:00:03", "2018-08-04T10:00:06")
+		cc.reply("get_atomicswap_contract", fmt.Sprintf(`{"secret_hash":%q,"secret":""}`, secretHash))
+		cc.reply("broadcast_transaction_synchronous", &protocol.RPCError{Code: 10, Message: "contract is not expired"}, `{}`)
+
+		_, err := swap.Wait(ctx, time.Millisecond)
+		require.Equal(t, ErrAtomicSwapRefunded, err)
+
+		refund := &types.RefundAtomicswapOperation{Participant: "alice", Initiator: "bob", SecretHash: secretHash}
+		require.Equal(t, []types.Operation{refund, refund}, cc.broadcastedOps())
+	})
+
+	t.Run("refund failed", func(t *testing.T) {
+		cc := newFakeCaller()
+		swap := newTestAtomicSwap(t, cc, secretHash)
+
+		cc.replyChainTime("2018-08-04T10:00:06")
+		cc.reply("get_atomicswap_contract", fmt.Sprintf(`{"secret_hash":%q,"secret":""}`, secretHash))
+		cc.reply("broadcast_transaction_synchronous", errors.New("connection lost"))
+
+		_, err := swap.Wait(ctx, time.Millisecond)
+		require.EqualError(t, err, "broadcast atomicswap refund: connection lost")
+		require.Len(t, cc.broadcasted, 1)
+	})
+
+	t.Run("refund rejected until canceled", func(t *testing.T) {
+		cc := newFakeCaller()
+		swap := newTestAtomicSwap(t, cc, secretHash)
+
+		cc.replyChainTime("2018-08-04T10:00:06")
+		cc.reply("get_atomicswap_contract", fmt.Sprintf(`{"secret_hash":%q,"secret":""}`, secretHash))
+		cc.reply("broadcast_transaction_synchronous", &protocol.RPCError{Code: 10, Message: "contract is not expired"})
+
+		ctx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+		defer cancel()
+
+		_, err := swap.Wait(ctx, time.Millisecond)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Contains(t, err.Error(), "contract is not expired")
+	})
+}
@@ -13,6 +13,13 @@ type Caller interface {
 	SetCallback(api string, method string, callback func(raw json.RawMessage)) error
 }
 
+// CallbackCaller is implemented by the transports which are able to call api methods
+// taking the callback id as the first argument, e.g. broadcast_transaction_with_callback.
+// The callback is registered until the returned cancel func is called.
+type CallbackCaller interface {
+	CallWithCallback(ctx context.Context, api string, method string, args []interface{}, callback func(raw json.RawMessage)) (cancel func(), err error)
+}
+
 type CallCloser interface {
 	Caller
 	io.Closer
@@ -6,9 +6,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/scorum/scorum-go/apis/account_by_key"
 	"github.com/scorum/scorum-go/apis/account_history"
 	"github.com/scorum/scorum-go/apis/betting"
 	"github.com/scorum/scorum-go/apis/blockchain_history"
+	"github.com/scorum/scorum-go/apis/blockchain_statistics"
 	"github.com/scorum/scorum-go/apis/chain"
 	"github.com/scorum/scorum-go/apis/database"
 	"github.com/scorum/scorum-go/apis/network_broadcast"
@@ -37,12 +39,18 @@ type Client struct {
 	// BlockchainHistory represents blockchain_history_api
 	BlockchainHistory *blockchain_history.API
 
+	// BlockchainStatistics represents blockchain_statistics_api
+	BlockchainStatistics *blockchain_statistics.API
+
 	// Betting represents betting_api
 	Betting *betting.API
 
 	// Chain represents chain_api
 	Chain *chain.API
 
+	// AccountByKey represents account_by_key_api
+	AccountByKey *account_by_key.API
+
 	getReferenceBlock getReferenceBlock
 }
 
@@ -72,7 +80,9 @@ func NewClient(cc caller.CallCloser, opts ...Option) *Client {
 	client.AccountHistory = account_history.NewAPI(client.cc)
 	client.NetworkBroadcast = network_broadcast.NewAPI(client.cc)
 	client.BlockchainHistory = blockchain_history.NewAPI(client.cc)
+	client.BlockchainStatistics = blockchain_statistics.NewAPI(client.cc)
 	client.Betting = betting.NewAPI(client.cc)
+	client.AccountByKey = account_by_key.NewAPI(client.cc)
 	client.getReferenceBlock = client.getLastIrreversibleBlockReference
 
 	for _, opt := range opts {
@@ -89,7 +99,7 @@ func (client *Client) Close() error {
 }
 
 func (client *Client) BroadcastTransactionSynchronous(ctx context.Context, chainID []byte, operations []types.Operation, keys ...*key.PrivateKey) (*network_broadcast.BroadcastResponse, error) {
-	stx, err := client.createSignedTransaction(ctx, chainID, operations, keys...)
+	stx, err := client.CreateSignedTransaction(ctx, chainID, operations, keys...)
 	if err != nil {
 		return nil, err
 	}
@@ -97,7 +107,7 @@ func (client *Client) BroadcastTransactionSynchronous(ctx context.Context, chain
 }
 
 func (client *Client) BroadcastTransaction(ctx context.Context, chainID []byte, operations []types.Operation, keys ...*key.PrivateKey) (string, error) {
-	stx, err := client.createSignedTransaction(ctx, chainID, operations, keys...)
+	stx, err := client.CreateSignedTransaction(ctx, chainID, operations, keys...)
 	if err != nil {
 		return "", err
 	}
@@ -107,18 +117,19 @@ func (client *Client) BroadcastTransaction(ctx context.Context, chainID []byte,
 	return hex.EncodeToString(id), client.NetworkBroadcast.BroadcastTransaction(ctx, stx.Transaction)
 }
 
-func (client *Client) createSignedTransaction(ctx context.Context, chainID []byte, operations []types.Operation, keys ...*key.PrivateKey) (*sign.SignedTransaction, error) {
+// CreateSignedTransaction creates a transaction referencing a recent block and signs it with the given keys.
+// It can be checked with Database.VerifyAuthority before broadcasting.
+func (client *Client) CreateSignedTransaction(ctx context.Context, chainID []byte, operations []types.Operation, keys ...*key.PrivateKey) (*sign.SignedTransaction, error) {
 	refBlock, err := client.getReferenceBlock(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	expiration := refBlock.time.Add(10 * time.Minute)
 	stx := sign.NewSignedTransaction(&types.Transaction{
 		Operations:     operations,
 		RefBlockNum:    refBlock.number,
 		RefBlockPrefix: refBlock.prefix,
-		Expiration:     &types.Time{Time: &expiration},
+		Expiration:     refBlock.time.Add(10 * time.Minute),
 	})
 
 	if err = stx.Sign(chainID, keys...); err != nil {
@@ -0,0 +1,136 @@
+package transaction
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/google/uuid"
+)
+
+// maxSymbolLen is the size of the asset symbol, shorter symbols are padded with zeros.
+const maxSymbolLen = 7
+
+var errInvalidMoney = errors.New("expecting amount like '99.000 SCR'")
+
+// The Append functions append the binary encoded value to dst and return the extended slice,
+// they don't allocate unless dst has to grow.
+
+func AppendUVarint(dst []byte, v uint64) []byte {
+	return binary.AppendUvarint(dst, v)
+}
+
+// AppendVarint appends the value zigzag encoded as fc signed_int does, so e.g. 5 is 0a and -5 is 09.
+// The earlier versions wrote the non-negative values as uvarint, which fc and DecodeVarint read differently.
+func AppendVarint(dst []byte, v int64) []byte {
+	return binary.AppendVarint(dst, v)
+}
+
+func AppendBool(dst []byte, v bool) []byte {
+	if v {
+		return append(dst, 1)
+	}
+	return append(dst, 0)
+}
+
+func AppendUint16(dst []byte, v uint16) []byte {
+	return binary.LittleEndian.AppendUint16(dst, v)
+}
+
+func AppendUint32(dst []byte, v uint32) []byte {
+	return binary.LittleEndian.AppendUint32(dst, v)
+}
+
+func AppendUint64(dst []byte, v uint64) []byte {
+	return binary.LittleEndian.AppendUint64(dst, v)
+}
+
+// AppendString appends the length of the string followed by its bytes.
+func AppendString(dst []byte, s string) []byte {
+	dst = AppendUVarint(dst, uint64(len(s)))
+	return append(dst, s...)
+}
+
+func AppendUUID(dst []byte, id uuid.UUID) []byte {
+	return append(dst, id[:]...)
+}
+
+// AppendAmount appends the asset: the amount in the smallest units, the precision and the zero padded symbol.
+func AppendAmount(dst []byte, amount int64, precision uint8, symbol string) ([]byte, error) {
+	if len(symbol) == 0 || len(symbol) > maxSymbolLen {
+		return dst, fmt.Errorf("encoder: invalid asset symbol %q", symbol)
+	}
+
+	dst = AppendUint64(dst, uint64(amount))
+	dst = append(dst, precision)
+	dst = append(dst, symbol...)
+	for i := len(symbol); i < maxSymbolLen; i++ {
+		dst = append(dst, 0)
+	}
+	return dst, nil
+}
+
+// AppendMoney appends the asset given as a string like "1.000000000 SCR", see AppendAmount.
+func AppendMoney(dst []byte, s string) ([]byte, error) {
+	amount, precision, symbol, err := parseMoney(s)
+	if err != nil {
+		return dst, err
+	}
+	return AppendAmount(dst, amount, precision, symbol)
+}
+
+// parseMoney parses the string like "99.000 SCR", the precision is the number of digits after the point.
+func parseMoney(s string) (amount int64, precision uint8, symbol string, err error) {
+	var (
+		i        int
+		digits   int
+		fraction = -1
+		overflow bool
+	)
+	for ; i < len(s) && s[i] != ' '; i++ {
+		c := s[i]
+		switch {
+		case c >= '0' && c <= '9':
+			digits++
+			if fraction >= 0 {
+				fraction++
+			}
+			d := int64(c - '0')
+			if amount > (math.MaxInt64-d)/10 {
+				overflow = true
+			}
+			amount = amount*10 + d
+		case c == '.' && fraction < 0 && i > 0:
+			fraction = 0
+		default:
+			return 0, 0, "", errInvalidMoney
+		}
+	}
+
+	if digits == 0 || i == len(s) {
+		return 0, 0, "", errInvalidMoney
+	}
+
+	symbol = s[i+1:]
+	if len(symbol) == 0 {
+		return 0, 0, "", errInvalidMoney
+	}
+	for j := 0; j < len(symbol); j++ {
+		c := symbol[j]
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z') {
+			return 0, 0, "", errInvalidMoney
+		}
+	}
+
+	if overflow || amount == math.MaxInt64 {
+		return 0, 0, "", fmt.Errorf("encoder: value cannot be equal or greater than %d", int64(math.MaxInt64))
+	}
+	if fraction > math.MaxUint8 {
+		return 0, 0, "", errInvalidMoney
+	}
+	if fraction > 0 {
+		precision = uint8(fraction)
+	}
+	return amount, precision, symbol, nil
+}
@@ -0,0 +1,68 @@
+package transaction
+
+import "sync"
+
+// maxPooledBufferSize limits the capacity of the buffers returned to the pool,
+// so a single huge transaction doesn't keep the memory forever.
+const maxPooledBufferSize = 64 << 10
+
+// Buffer is the append-style byte buffer transactions are encoded into.
+// The Encoder of the buffer appends the values directly without any intermediate writes,
+// use AcquireBuffer and ReleaseBuffer to reuse the buffers on the hot path.
+type Buffer struct {
+	b   []byte
+	enc Encoder
+}
+
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		return &Buffer{b: make([]byte, 0, 512)}
+	},
+}
+
+// AcquireBuffer returns an empty buffer from the pool.
+func AcquireBuffer() *Buffer {
+	return bufferPool.Get().(*Buffer)
+}
+
+// ReleaseBuffer returns the buffer to the pool, neither the buffer nor its bytes may be used after that.
+func ReleaseBuffer(b *Buffer) {
+	if cap(b.b) > maxPooledBufferSize {
+		return
+	}
+	b.Reset()
+	bufferPool.Put(b)
+}
+
+// Encoder returns the encoder appending to the buffer.
+func (b *Buffer) Encoder() *Encoder {
+	if b.enc.buf == nil {
+		b.enc = Encoder{w: b, buf: b}
+	}
+	return &b.enc
+}
+
+// Bytes returns the encoded bytes, the slice is valid until the next modification of the buffer.
+func (b *Buffer) Bytes() []byte {
+	return b.b
+}
+
+func (b *Buffer) Len() int {
+	return len(b.b)
+}
+
+func (b *Buffer) Reset() {
+	b.b = b.b[:0]
+}
+
+// Write implements io.Writer.
+func (b *Buffer) Write(p []byte) (int, error) {
+	b.b = append(b.b, p...)
+	return len(p), nil
+}
+
+// WriteString implements io.StringWriter.
+func (b *Buffer) WriteString(s string) (int, error) {
+	b.b = append(b.b, s...)
+	return len(s), nil
+}
@@ -0,0 +1,222 @@
+package transaction
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// maxLength limits the decoded strings and arrays length to protect from allocating a huge buffer on a malformed input.
+const maxLength = 1 << 20
+
+type Decoder struct {
+	r *bufio.Reader
+}
+
+// NewDecoder creates a decoder reading from r.
+// The decoder is buffered and may read beyond the decoded data.
+func NewDecoder(r io.Reader) *Decoder {
+	if br, ok := r.(*bufio.Reader); ok {
+		return &Decoder{br}
+	}
+	return &Decoder{bufio.NewReader(r)}
+}
+
+func (decoder *Decoder) DecodeVarint() (int64, error) {
+	i, err := binary.ReadVarint(decoder.r)
+	if err != nil {
+		return 0, fmt.Errorf("decoder: failed to read varint: %w", err)
+	}
+	return i, nil
+}
+
+func (decoder *Decoder) DecodeUVarint() (uint64, error) {
+	i, err := binary.ReadUvarint(decoder.r)
+	if err != nil {
+		return 0, fmt.Errorf("decoder: failed to read uvarint: %w", err)
+	}
+	return i, nil
+}
+
+// PeekUVarint returns the next uvarint without consuming it, e.g. a static variant tag.
+func (decoder *Decoder) PeekUVarint() (uint64, error) {
+	b, err := decoder.r.Peek(binary.MaxVarintLen64)
+	if err != nil && len(b) == 0 {
+		return 0, fmt.Errorf("decoder: failed to peek uvarint: %w", err)
+	}
+
+	i, n := binary.Uvarint(b)
+	if n <= 0 {
+		return 0, errors.New("decoder: failed to peek uvarint: malformed varint")
+	}
+	return i, nil
+}
+
+// ExpectEOF checks the whole input is decoded. As the decoder is buffered,
+// the trailing bytes can't be detected by the underlying reader.
+func (decoder *Decoder) ExpectEOF() error {
+	if _, err := decoder.r.ReadByte(); err != io.EOF {
+		if err != nil {
+			return fmt.Errorf("decoder: failed to check the end of input: %w", err)
+		}
+		return errors.New("decoder: unexpected trailing bytes")
+	}
+	return nil
+}
+
+// DecodeLength reads the uvarint length of a string or an array.
+func (decoder *Decoder) DecodeLength() (int, error) {
+	l, err := decoder.DecodeUVarint()
+	if err != nil {
+		return 0, err
+	}
+
+	if l > maxLength {
+		return 0, fmt.Errorf("decoder: length %d is too big", l)
+	}
+	return int(l), nil
+}
+
+// DecodeNumber reads a little endian fixed size number into v, which must be a pointer.
+func (decoder *Decoder) DecodeNumber(v interface{}) error {
+	if err := binary.Read(decoder.r, binary.LittleEndian, v); err != nil {
+		return fmt.Errorf("decoder: failed to read number: %w", err)
+	}
+	return nil
+}
+
+func (decoder *Decoder) DecodeBool() (bool, error) {
+	var b uint8
+	if err := decoder.DecodeNumber(&b); err != nil {
+		return false, err
+	}
+
+	switch b {
+	case 0:
+		return false, nil
+	case 1:
+		return true, nil
+	default:
+		return false, fmt.Errorf("decoder: invalid bool value %d", b)
+	}
+}
+
+func (decoder *Decoder) DecodeString() (string, error) {
+	l, err := decoder.DecodeLength()
+	if err != nil {
+		return "", fmt.Errorf("decoder: failed to read string length: %w", err)
+	}
+
+	b, err := decoder.DecodeBytes(l)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// DecodeBytes reads exactly n bytes.
+func (decoder *Decoder) DecodeBytes(n int) ([]byte, error) {
+	b := make([]byte, n)
+	if _, err := io.ReadFull(decoder.r, b); err != nil {
+		return nil, fmt.Errorf("decoder: failed to read %d bytes: %w", n, err)
+	}
+	return b, nil
+}
+
+// DecodeAmount reads an asset encoded by EncodeAmount: the amount in the smallest units, the precision and the symbol.
+func (decoder *Decoder) DecodeAmount() (amount int64, precision uint8, symbol string, err error) {
+	if err := decoder.DecodeNumber(&amount); err != nil {
+		return 0, 0, "", err
+	}
+	if err := decoder.DecodeNumber(&precision); err != nil {
+		return 0, 0, "", err
+	}
+
+	b, err := decoder.DecodeBytes(maxSymbolLen)
+	if err != nil {
+		return 0, 0, "", err
+	}
+	return amount, precision, string(bytes.TrimRight(b, "\x00")), nil
+}
+
+// DecodeMoney reads an asset encoded by EncodeMoney and returns it in the '99.000 SCR' form.
+func (decoder *Decoder) DecodeMoney() (string, error) {
+	amount, precision, symbol, err := decoder.DecodeAmount()
+	if err != nil {
+		return "", err
+	}
+
+	if amount < 0 {
+		return "", fmt.Errorf("decoder: negative amount %d", amount)
+	}
+
+	digits := strconv.FormatInt(amount, 10)
+	if p := int(precision); p > 0 {
+		if len(digits) <= p {
+			digits = strings.Repeat("0", p-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-p] + "." + digits[len(digits)-p:]
+	}
+
+	return digits + " " + symbol, nil
+}
+
+func (decoder *Decoder) DecodeUUID() (uuid.UUID, error) {
+	var id uuid.UUID
+	b, err := decoder.DecodeBytes(len(id))
+	if err != nil {
+		return id, err
+	}
+
+	if err := id.UnmarshalBinary(b); err != nil {
+		return id, fmt.Errorf("decoder: failed to read uuid: %w", err)
+	}
+	return id, nil
+}
+
+// Decode reads v, which must be either a TransactionUnmarshaller or a pointer to a number, bool or string.
+func (decoder *Decoder) Decode(v interface{}) error {
+	if unmarshaller, ok := v.(TransactionUnmarshaller); ok {
+		return unmarshaller.UnmarshalTransaction(decoder)
+	}
+
+	switch v := v.(type) {
+	case *int:
+		var i int32
+		if err := decoder.DecodeNumber(&i); err != nil {
+			return err
+		}
+		*v = int(i)
+		return nil
+	case *uint:
+		var i uint32
+		if err := decoder.DecodeNumber(&i); err != nil {
+			return err
+		}
+		*v = uint(i)
+		return nil
+
+	case *int8, *int16, *int32, *int64, *uint8, *uint16, *uint32, *uint64:
+		return decoder.DecodeNumber(v)
+
+	case *bool:
+		b, err := decoder.DecodeBool()
+		*v = b
+		return err
+
+	case *string:
+		s, err := decoder.DecodeString()
+		*v = s
+		return err
+
+	default:
+		return fmt.Errorf("decoder: unsupported type (%T) encountered", v)
+	}
+}
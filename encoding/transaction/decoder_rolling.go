@@ -0,0 +1,111 @@
+package transaction
+
+import "github.com/google/uuid"
+
+// RollingDecoder keeps the first error, all the following calls are no-op and return zero values.
+type RollingDecoder struct {
+	next *Decoder
+	err  error
+}
+
+func NewRollingDecoder(next *Decoder) *RollingDecoder {
+	return &RollingDecoder{next, nil}
+}
+
+func (decoder *RollingDecoder) DecodeVarint() int64 {
+	if decoder.err != nil {
+		return 0
+	}
+
+	var i int64
+	i, decoder.err = decoder.next.DecodeVarint()
+	return i
+}
+
+func (decoder *RollingDecoder) DecodeUVarint() uint64 {
+	if decoder.err != nil {
+		return 0
+	}
+
+	var i uint64
+	i, decoder.err = decoder.next.DecodeUVarint()
+	return i
+}
+
+func (decoder *RollingDecoder) DecodeLength() int {
+	if decoder.err != nil {
+		return 0
+	}
+
+	var l int
+	l, decoder.err = decoder.next.DecodeLength()
+	return l
+}
+
+func (decoder *RollingDecoder) DecodeNumber(v interface{}) {
+	if decoder.err == nil {
+		decoder.err = decoder.next.DecodeNumber(v)
+	}
+}
+
+func (decoder *RollingDecoder) DecodeBool() bool {
+	if decoder.err != nil {
+		return false
+	}
+
+	var b bool
+	b, decoder.err = decoder.next.DecodeBool()
+	return b
+}
+
+func (decoder *RollingDecoder) Decode(v interface{}) {
+	if decoder.err == nil {
+		decoder.err = decoder.next.Decode(v)
+	}
+}
+
+func (decoder *RollingDecoder) DecodeMoney() string {
+	if decoder.err != nil {
+		return ""
+	}
+
+	var s string
+	s, decoder.err = decoder.next.DecodeMoney()
+	return s
+}
+
+func (decoder *RollingDecoder) DecodeAmount() (amount int64, precision uint8, symbol string) {
+	if decoder.err != nil {
+		return 0, 0, ""
+	}
+
+	amount, precision, symbol, decoder.err = decoder.next.DecodeAmount()
+	return amount, precision, symbol
+}
+
+func (decoder *RollingDecoder) DecodeUUID() uuid.UUID {
+	if decoder.err != nil {
+		return uuid.UUID{}
+	}
+
+	var id uuid.UUID
+	id, decoder.err = decoder.next.DecodeUUID()
+	return id
+}
+
+func (decoder *RollingDecoder) DecodeStruct(v interface{}) {
+	if decoder.err == nil {
+		decoder.err = decoder.next.DecodeStruct(v)
+	}
+}
+
+// SetErr records the error unless there is one already.
+func (decoder *RollingDecoder) SetErr(err error) {
+	if decoder.err == nil {
+		decoder.err = err
+	}
+}
+
+func (decoder *RollingDecoder) Err() error {
+	return decoder.err
+}
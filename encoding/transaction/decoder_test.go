@@ -0,0 +1,102 @@
+package transaction
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecoder_DecodeMoney(t *testing.T) {
+	for _, money := range []string{
+		"1.000000000 SCR",
+		"0.000000001 SCR",
+		"1000000.000000000 SP",
+		"99 SCR",
+		"0.5 TESTS",
+	} {
+		t.Run(money, func(t *testing.T) {
+			var b bytes.Buffer
+			require.NoError(t, NewEncoder(&b).EncodeMoney(money))
+
+			decoded, err := NewDecoder(&b).DecodeMoney()
+			require.NoError(t, err)
+			require.Equal(t, money, decoded)
+		})
+	}
+}
+
+func TestDecoder_Decode(t *testing.T) {
+	var b bytes.Buffer
+	enc := NewRollingEncoder(NewEncoder(&b))
+	enc.EncodeUVarint(300)
+	enc.EncodeVarint(-5)
+	enc.Encode(int16(-2))
+	enc.Encode(uint32(7))
+	enc.Encode("alice")
+	enc.EncodeBool(true)
+	enc.EncodeUUID(uuid.MustParse("e629f9aa-6b2c-46aa-8fa8-36770e7a7a5f"))
+	require.NoError(t, enc.Err())
+
+	var (
+		dec = NewRollingDecoder(NewDecoder(&b))
+		i16 int16
+		u32 uint32
+		s   string
+	)
+	require.EqualValues(t, 300, dec.DecodeUVarint())
+	require.EqualValues(t, -5, dec.DecodeVarint())
+	dec.Decode(&i16)
+	dec.Decode(&u32)
+	dec.Decode(&s)
+	require.True(t, dec.DecodeBool())
+	require.Equal(t, "e629f9aa-6b2c-46aa-8fa8-36770e7a7a5f", dec.DecodeUUID().String())
+	require.NoError(t, dec.Err())
+
+	require.EqualValues(t, -2, i16)
+	require.EqualValues(t, 7, u32)
+	require.Equal(t, "alice", s)
+
+	// the input is exhausted
+	dec.Decode(&s)
+	require.Error(t, dec.Err())
+}
+
+func TestDecoder_PeekUVarint(t *testing.T) {
+	b, err := hex.DecodeString("ac02")
+	require.NoError(t, err)
+
+	dec := NewDecoder(bytes.NewReader(b))
+	peeked, err := dec.PeekUVarint()
+	require.NoError(t, err)
+	require.EqualValues(t, 300, peeked)
+
+	decoded, err := dec.DecodeUVarint()
+	require.NoError(t, err)
+	require.EqualValues(t, 300, decoded)
+}
+
+func TestDecoder_DecodeString_TooLong(t *testing.T) {
+	b, err := hex.DecodeString("ffffffff0f")
+	require.NoError(t, err)
+
+	_, err = NewDecoder(bytes.NewReader(b)).DecodeString()
+	require.Error(t, err)
+}
+
+func TestDecoder_ExpectEOF(t *testing.T) {
+	b, err := hex.DecodeString("ac0201")
+	require.NoError(t, err)
+
+	dec := NewDecoder(bytes.NewReader(b))
+	_, err = dec.DecodeUVarint()
+	require.NoError(t, err)
+	require.Error(t, dec.ExpectEOF(), "the buffered byte is left")
+
+	dec = NewDecoder(bytes.NewReader(b[:2]))
+	_, err = dec.DecodeUVarint()
+	require.NoError(t, err)
+	require.NoError(t, dec.ExpectEOF())
+}
@@ -2,42 +2,92 @@ package transaction
 
 import (
 	"encoding/binary"
-	"errors"
 	"fmt"
 	"io"
-	"math"
-	"regexp"
-	"strconv"
-	"strings"
 
 	"github.com/google/uuid"
 )
 
+// Encoder writes the binary encoded values to the writer.
+// When the writer is a Buffer the values are appended to it directly, see AcquireBuffer.
 type Encoder struct {
-	w io.Writer
+	w   io.Writer
+	buf *Buffer
+
+	// scratch is reused to encode the values before they are written to w
+	scratch []byte
 }
 
 func NewEncoder(w io.Writer) *Encoder {
-	return &Encoder{w}
+	if b, ok := w.(*Buffer); ok {
+		return &Encoder{w: w, buf: b}
+	}
+	return &Encoder{w: w}
 }
 
+// EncodeVarint writes the zigzag encoded value, see AppendVarint.
 func (encoder *Encoder) EncodeVarint(i int64) error {
-	if i >= 0 {
-		return encoder.EncodeUVarint(uint64(i))
-	}
-
-	b := make([]byte, binary.MaxVarintLen64)
-	n := binary.PutVarint(b, i)
-	return encoder.writeBytes(b[:n])
+	return encoder.flush(AppendVarint(encoder.dst(), i))
 }
 
 func (encoder *Encoder) EncodeUVarint(i uint64) error {
-	b := make([]byte, binary.MaxVarintLen64)
-	n := binary.PutUvarint(b, i)
-	return encoder.writeBytes(b[:n])
+	return encoder.flush(AppendUVarint(encoder.dst(), i))
+}
+
+func (encoder *Encoder) EncodeInt8(v int8) error {
+	return encoder.flush(append(encoder.dst(), byte(v)))
+}
+
+func (encoder *Encoder) EncodeInt16(v int16) error {
+	return encoder.flush(AppendUint16(encoder.dst(), uint16(v)))
+}
+
+func (encoder *Encoder) EncodeInt32(v int32) error {
+	return encoder.flush(AppendUint32(encoder.dst(), uint32(v)))
+}
+
+func (encoder *Encoder) EncodeInt64(v int64) error {
+	return encoder.flush(AppendUint64(encoder.dst(), uint64(v)))
+}
+
+func (encoder *Encoder) EncodeUint8(v uint8) error {
+	return encoder.flush(append(encoder.dst(), v))
+}
+
+func (encoder *Encoder) EncodeUint16(v uint16) error {
+	return encoder.flush(AppendUint16(encoder.dst(), v))
 }
 
+func (encoder *Encoder) EncodeUint32(v uint32) error {
+	return encoder.flush(AppendUint32(encoder.dst(), v))
+}
+
+func (encoder *Encoder) EncodeUint64(v uint64) error {
+	return encoder.flush(AppendUint64(encoder.dst(), v))
+}
+
+// EncodeNumber encodes the fixed size number, the typed methods like EncodeUint32 should be preferred
+// on the hot path as passing a number as interface{} may allocate.
 func (encoder *Encoder) EncodeNumber(v interface{}) error {
+	switch v := v.(type) {
+	case int8:
+		return encoder.EncodeInt8(v)
+	case int16:
+		return encoder.EncodeInt16(v)
+	case int32:
+		return encoder.EncodeInt32(v)
+	case int64:
+		return encoder.EncodeInt64(v)
+	case uint8:
+		return encoder.EncodeUint8(v)
+	case uint16:
+		return encoder.EncodeUint16(v)
+	case uint32:
+		return encoder.EncodeUint32(v)
+	case uint64:
+		return encoder.EncodeUint64(v)
+	}
+
 	if err := binary.Write(encoder.w, binary.LittleEndian, v); err != nil {
 		return fmt.Errorf("encoder: failed to write number: %v: %w", v, err)
 	}
@@ -45,11 +95,7 @@ func (encoder *Encoder) EncodeNumber(v interface{}) error {
 }
 
 func (encoder *Encoder) EncodeBool(b bool) error {
-	if b {
-		return encoder.EncodeNumber(byte(1))
-	} else {
-		return encoder.EncodeNumber(byte(0))
-	}
+	return encoder.flush(AppendBool(encoder.dst(), b))
 }
 
 func (encoder *Encoder) Encode(v interface{}) error {
@@ -59,29 +105,29 @@ func (encoder *Encoder) Encode(v interface{}) error {
 
 	switch v := v.(type) {
 	case int:
-		return encoder.EncodeNumber(int32(v))
+		return encoder.EncodeInt32(int32(v))
 	case int8:
-		return encoder.EncodeNumber(v)
+		return encoder.EncodeInt8(v)
 	case int16:
-		return encoder.EncodeNumber(v)
+		return encoder.EncodeInt16(v)
 	case int32:
-		return encoder.EncodeNumber(v)
+		return encoder.EncodeInt32(v)
 	case int64:
-		return encoder.EncodeNumber(v)
+		return encoder.EncodeInt64(v)
 
 	case uint:
 		return encoder.EncodeNumber(v)
 	case uint8:
-		return encoder.EncodeNumber(v)
+		return encoder.EncodeUint8(v)
 	case uint16:
-		return encoder.EncodeNumber(v)
+		return encoder.EncodeUint16(v)
 	case uint32:
-		return encoder.EncodeNumber(v)
+		return encoder.EncodeUint32(v)
 	case uint64:
-		return encoder.EncodeNumber(v)
+		return encoder.EncodeUint64(v)
 
 	case string:
-		return encoder.encodeString(v)
+		return encoder.EncodeString(v)
 
 	case []byte:
 		return encoder.writeBytes(v)
@@ -91,62 +137,69 @@ func (encoder *Encoder) Encode(v interface{}) error {
 	}
 }
 
+// EncodeMoney encodes the asset given as a string like "99.000 SCR".
 func (encoder *Encoder) EncodeMoney(s string) error {
-	r, _ := regexp.Compile("^[0-9]+\\.?[0-9]* [A-Za-z0-9]+$")
-	if r.MatchString(s) {
-		asset := strings.Split(s, " ")
-		ind := strings.Index(asset[0], ".")
-		amm, _ := strconv.ParseInt(strings.Replace(asset[0], ".", "", -1), 10, 64)
-
-		if amm == math.MaxInt64 {
-			return fmt.Errorf("encoder: value cannot be equal or greater than %d", math.MaxInt64)
-		}
-
-		var perc int
-		if ind == -1 {
-			perc = 0
-		} else {
-			perc = len(asset[0]) - ind - 1
-		}
-		if err := binary.Write(encoder.w, binary.LittleEndian, amm); err != nil {
-			return fmt.Errorf("encoder: failed to write number: %v: %w", amm, err)
-		}
-		if err := binary.Write(encoder.w, binary.LittleEndian, byte(perc)); err != nil {
-			return fmt.Errorf("encoder: failed to write number: %v: %w", perc, err)
-		}
-
-		if _, err := io.Copy(encoder.w, strings.NewReader(asset[1])); err != nil {
-			return fmt.Errorf("encoder: failed to write string: %v: %w", asset[1], err)
-		}
-
-		for i := byte(len(asset[1])); i < 7; i++ {
-			if err := binary.Write(encoder.w, binary.LittleEndian, byte(0)); err != nil {
-				return fmt.Errorf("encoder: failed to write number: %v: %w", 0, err)
-			}
-		}
-		return nil
-	} else {
-		return errors.New("expecting amount like '99.000 SCR'")
+	b, err := AppendMoney(encoder.dst(), s)
+	if err != nil {
+		return err
 	}
+	return encoder.flush(b)
 }
 
-func (encoder *Encoder) EncodeUUID(id uuid.UUID) error {
-	bytes, err := id.MarshalBinary()
+// EncodeAmount encodes the asset given by the amount in the smallest units, the precision and the symbol.
+func (encoder *Encoder) EncodeAmount(amount int64, precision uint8, symbol string) error {
+	b, err := AppendAmount(encoder.dst(), amount, precision, symbol)
 	if err != nil {
 		return err
 	}
-	return encoder.writeBytes(bytes)
+	return encoder.flush(b)
 }
 
-func (encoder *Encoder) encodeString(v string) error {
+func (encoder *Encoder) EncodeUUID(id uuid.UUID) error {
+	return encoder.flush(AppendUUID(encoder.dst(), id))
+}
+
+// EncodeString encodes the length of the string followed by its bytes.
+func (encoder *Encoder) EncodeString(v string) error {
+	if encoder.buf != nil {
+		encoder.buf.b = AppendString(encoder.buf.b, v)
+		return nil
+	}
+
 	if err := encoder.EncodeUVarint(uint64(len(v))); err != nil {
 		return fmt.Errorf("encoder: failed to write string: %v: %w", v, err)
 	}
-
 	return encoder.writeString(v)
 }
 
+// dst returns the slice the value is appended to before it is passed to flush.
+func (encoder *Encoder) dst() []byte {
+	if encoder.buf != nil {
+		return encoder.buf.b
+	}
+	return encoder.scratch[:0]
+}
+
+// flush stores the slice returned by dst with the value appended.
+func (encoder *Encoder) flush(b []byte) error {
+	if encoder.buf != nil {
+		encoder.buf.b = b
+		return nil
+	}
+
+	encoder.scratch = b[:0]
+	if _, err := encoder.w.Write(b); err != nil {
+		return fmt.Errorf("encoder: failed to write bytes: %v: %w", b, err)
+	}
+	return nil
+}
+
 func (encoder *Encoder) writeBytes(bs []byte) error {
+	if encoder.buf != nil {
+		encoder.buf.b = append(encoder.buf.b, bs...)
+		return nil
+	}
+
 	if _, err := encoder.w.Write(bs); err != nil {
 		return fmt.Errorf("encoder: failed to write bytes: %v: %w", bs, err)
 	}
@@ -154,7 +207,7 @@ func (encoder *Encoder) writeBytes(bs []byte) error {
 }
 
 func (encoder *Encoder) writeString(s string) error {
-	if _, err := io.Copy(encoder.w, strings.NewReader(s)); err != nil {
+	if _, err := io.WriteString(encoder.w, s); err != nil {
 		return fmt.Errorf("encoder: failed to write string: %v: %w", s, err)
 	}
 	return nil
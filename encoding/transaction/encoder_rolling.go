@@ -29,6 +29,54 @@ func (encoder *RollingEncoder) EncodeNumber(v interface{}) {
 	}
 }
 
+func (encoder *RollingEncoder) EncodeInt8(v int8) {
+	if encoder.err == nil {
+		encoder.err = encoder.next.EncodeInt8(v)
+	}
+}
+
+func (encoder *RollingEncoder) EncodeInt16(v int16) {
+	if encoder.err == nil {
+		encoder.err = encoder.next.EncodeInt16(v)
+	}
+}
+
+func (encoder *RollingEncoder) EncodeInt32(v int32) {
+	if encoder.err == nil {
+		encoder.err = encoder.next.EncodeInt32(v)
+	}
+}
+
+func (encoder *RollingEncoder) EncodeInt64(v int64) {
+	if encoder.err == nil {
+		encoder.err = encoder.next.EncodeInt64(v)
+	}
+}
+
+func (encoder *RollingEncoder) EncodeUint8(v uint8) {
+	if encoder.err == nil {
+		encoder.err = encoder.next.EncodeUint8(v)
+	}
+}
+
+func (encoder *RollingEncoder) EncodeUint16(v uint16) {
+	if encoder.err == nil {
+		encoder.err = encoder.next.EncodeUint16(v)
+	}
+}
+
+func (encoder *RollingEncoder) EncodeUint32(v uint32) {
+	if encoder.err == nil {
+		encoder.err = encoder.next.EncodeUint32(v)
+	}
+}
+
+func (encoder *RollingEncoder) EncodeUint64(v uint64) {
+	if encoder.err == nil {
+		encoder.err = encoder.next.EncodeUint64(v)
+	}
+}
+
 func (encoder *RollingEncoder) EncodeBool(v bool) {
 	if encoder.err == nil {
 		encoder.err = encoder.next.EncodeBool(v)
@@ -47,6 +95,18 @@ func (encoder *RollingEncoder) EncodeMoney(s string) {
 	}
 }
 
+func (encoder *RollingEncoder) EncodeAmount(amount int64, precision uint8, symbol string) {
+	if encoder.err == nil {
+		encoder.err = encoder.next.EncodeAmount(amount, precision, symbol)
+	}
+}
+
+func (encoder *RollingEncoder) EncodeString(s string) {
+	if encoder.err == nil {
+		encoder.err = encoder.next.EncodeString(s)
+	}
+}
+
 func (encoder *RollingEncoder) EncodeUUID(id uuid.UUID) {
 	if encoder.err == nil {
 		encoder.err = encoder.next.EncodeUUID(id)
@@ -56,3 +116,9 @@ func (encoder *RollingEncoder) EncodeUUID(id uuid.UUID) {
 func (encoder *RollingEncoder) Err() error {
 	return encoder.err
 }
+
+func (encoder *RollingEncoder) EncodeStruct(v interface{}) {
+	if encoder.err == nil {
+		encoder.err = encoder.next.EncodeStruct(v)
+	}
+}
@@ -2,8 +2,10 @@ package transaction
 
 import (
 	"bytes"
+	"encoding/hex"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 )
 
@@ -22,3 +24,133 @@ func TestEncoder_EncodeMoney_ValueOverflow(t *testing.T) {
 	err := encoder.EncodeMoney("11111111111111111111111111111111111111 SCR")
 	require.Error(t, err)
 }
+
+func TestEncoder_EncodeMoney(t *testing.T) {
+	for money, expected := range map[string]string{
+		"1.000000000 SCR": "00ca9a3b00000000" + "09" + "53435200000000",
+		"0.000000001 SP":  "0100000000000000" + "09" + "53500000000000",
+		"99 SCR":          "6300000000000000" + "00" + "53435200000000",
+		"99. SCR":         "6300000000000000" + "00" + "53435200000000",
+		"0.5 TESTS":       "0500000000000000" + "01" + "54455354530000",
+	} {
+		t.Run(money, func(t *testing.T) {
+			var b bytes.Buffer
+			require.NoError(t, NewEncoder(&b).EncodeMoney(money))
+			require.Equal(t, expected, hex.EncodeToString(b.Bytes()))
+		})
+	}
+
+	for _, money := range []string{
+		"",
+		"SCR",
+		"1.0",
+		"1.0 ",
+		".5 SCR",
+		"1.0.0 SCR",
+		"-1.0 SCR",
+		"1.0  SCR",
+		"1.0 S-R",
+		"1.0 SCRSCRSCR",
+		"9223372036854775807 SCR",
+	} {
+		t.Run(money, func(t *testing.T) {
+			require.Error(t, NewEncoder(new(bytes.Buffer)).EncodeMoney(money))
+		})
+	}
+}
+
+func TestEncoder_EncodeVarint(t *testing.T) {
+	for v, expected := range map[int64]string{0: "00", 5: "0a", 300: "d804", -1: "01", -5: "09"} {
+		var b bytes.Buffer
+		require.NoError(t, NewEncoder(&b).EncodeVarint(v))
+		require.Equal(t, expected, hex.EncodeToString(b.Bytes()), v)
+
+		decoded, err := NewDecoder(&b).DecodeVarint()
+		require.NoError(t, err)
+		require.Equal(t, v, decoded)
+	}
+}
+
+func TestEncoder_Buffer(t *testing.T) {
+	encode := func(encoder *Encoder) {
+		enc := NewRollingEncoder(encoder)
+		enc.EncodeUVarint(300)
+		enc.EncodeVarint(-5)
+		enc.EncodeInt8(-1)
+		enc.EncodeInt16(-2)
+		enc.EncodeInt32(-3)
+		enc.EncodeInt64(-4)
+		enc.EncodeUint8(1)
+		enc.EncodeUint16(2)
+		enc.EncodeUint32(3)
+		enc.EncodeUint64(4)
+		enc.EncodeNumber(int16(5))
+		enc.EncodeBool(true)
+		enc.EncodeString("alice")
+		enc.Encode([]byte{1, 2, 3})
+		enc.EncodeMoney("1.000000000 SCR")
+		enc.EncodeAmount(1000000000, 9, "SCR")
+		enc.EncodeUUID(uuid.MustParse("e629f9aa-6b2c-46aa-8fa8-36770e7a7a5f"))
+		require.NoError(t, enc.Err())
+	}
+
+	var expected bytes.Buffer
+	encode(NewEncoder(&expected))
+
+	b := AcquireBuffer()
+	defer ReleaseBuffer(b)
+	encode(b.Encoder())
+	require.Equal(t, expected.Bytes(), b.Bytes())
+
+	b.Reset()
+	encode(NewEncoder(b))
+	require.Equal(t, expected.Bytes(), b.Bytes())
+}
+
+func TestEncoder_Buffer_ZeroAllocs(t *testing.T) {
+	id := uuid.MustParse("e629f9aa-6b2c-46aa-8fa8-36770e7a7a5f")
+	v := &struct {
+		Account string    `scorum:"0"`
+		Weight  int16     `scorum:"1"`
+		ID      uuid.UUID `scorum:"2,uuid"`
+		Fee     string    `scorum:"3,money"`
+	}{Account: "alice", Weight: -1, ID: id, Fee: "1.000000000 SCR"}
+
+	b := AcquireBuffer()
+	defer ReleaseBuffer(b)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		b.Reset()
+		enc := NewRollingEncoder(b.Encoder())
+		enc.EncodeUVarint(300)
+		enc.EncodeUint16(2)
+		enc.EncodeUint32(3)
+		enc.EncodeString("alice")
+		enc.EncodeMoney("1.000000000 SCR")
+		enc.EncodeUUID(id)
+		enc.EncodeStruct(v)
+		if enc.Err() != nil {
+			t.Fatal(enc.Err())
+		}
+	})
+	require.Zero(t, allocs)
+}
+
+func BenchmarkEncoder_Buffer(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf := AcquireBuffer()
+		enc := NewRollingEncoder(buf.Encoder())
+		enc.EncodeUint16(36029)
+		enc.EncodeUint32(1164960351)
+		enc.EncodeUVarint(1)
+		enc.EncodeString("azucena")
+		enc.EncodeString("leonarda")
+		enc.EncodeMoney("1.000000000 SCR")
+		enc.EncodeString("memo")
+		if enc.Err() != nil {
+			b.Fatal(enc.Err())
+		}
+		ReleaseBuffer(buf)
+	}
+}
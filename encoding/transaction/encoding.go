@@ -3,3 +3,7 @@ package transaction
 type TransactionMarshaller interface {
 	MarshalTransaction(*Encoder) error
 }
+
+type TransactionUnmarshaller interface {
+	UnmarshalTransaction(*Decoder) error
+}
@@ -0,0 +1,437 @@
+package transaction
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// TagName is the struct tag which describes the binary encoding of a field: `scorum:"index[,modifier]"`.
+// The fields are encoded in the order of their indexes, the fields without the tag are skipped.
+//
+// Modifiers, a field takes one at most:
+//
+//	money    - the field is an amount like "1.000000000 SCR", either a string or fmt.Stringer and encoding.TextUnmarshaler
+//	uuid     - the field is uuid.UUID encoded as 16 bytes
+//	optional - the pointer field is encoded as a presence flag followed by the value if any
+//	vector   - the slice field is encoded as the length followed by the elements, even if the slice type has its own encoding
+//	variant  - the field is a static variant which writes its own type index, it must implement TransactionMarshaller
+//	           and TransactionUnmarshaller which are always used, a nil variant can't be encoded
+//
+// Without a modifier the encoding is derived from the field type: TransactionMarshaller, bool, fixed size numbers,
+// string, uuid.UUID, slices as vectors and structs with tagged fields.
+const TagName = "scorum"
+
+type fieldKind int
+
+const (
+	kindDefault fieldKind = iota
+	kindMoney
+	kindUUID
+	kindOptional
+	kindVector
+	kindVariant
+)
+
+var fieldKinds = map[string]fieldKind{
+	"money":    kindMoney,
+	"uuid":     kindUUID,
+	"optional": kindOptional,
+	"vector":   kindVector,
+	"variant":  kindVariant,
+}
+
+type structField struct {
+	index int
+	name  string
+	field int
+	kind  fieldKind
+}
+
+var (
+	marshallerType      = reflect.TypeOf((*TransactionMarshaller)(nil)).Elem()
+	unmarshallerType    = reflect.TypeOf((*TransactionUnmarshaller)(nil)).Elem()
+	stringerType        = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	uuidType            = reflect.TypeOf(uuid.UUID{})
+)
+
+var structFieldsCache sync.Map // map[reflect.Type][]structField
+
+// structFields returns the tagged fields of the struct type ordered by their indexes.
+func structFields(t reflect.Type) ([]structField, error) {
+	if fields, ok := structFieldsCache.Load(t); ok {
+		return fields.([]structField), nil
+	}
+
+	var fields []structField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, ok := f.Tag.Lookup(TagName)
+		if !ok || tag == "-" {
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+		index, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: invalid field index %q", t, f.Name, parts[0])
+		}
+
+		if len(parts) > 2 {
+			return nil, fmt.Errorf("%s.%s: more than one modifier in %q", t, f.Name, tag)
+		}
+
+		field := structField{index: index, name: f.Name, field: i}
+		if len(parts) == 2 {
+			kind, ok := fieldKinds[parts[1]]
+			if !ok {
+				return nil, fmt.Errorf("%s.%s: unknown modifier %q", t, f.Name, parts[1])
+			}
+			field.kind = kind
+		}
+
+		if err := checkFieldKind(f.Type, field.kind); err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", t, f.Name, err)
+		}
+		fields = append(fields, field)
+	}
+
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].index < fields[j].index
+	})
+	for i := 1; i < len(fields); i++ {
+		if fields[i].index == fields[i-1].index {
+			return nil, fmt.Errorf("%s: fields %s and %s have the same index %d",
+				t, fields[i-1].name, fields[i].name, fields[i].index)
+		}
+	}
+
+	structFieldsCache.Store(t, fields)
+	return fields, nil
+}
+
+func implements(t, iface reflect.Type) bool {
+	return t.Implements(iface) || reflect.PtrTo(t).Implements(iface)
+}
+
+func checkFieldKind(t reflect.Type, kind fieldKind) error {
+	switch kind {
+	case kindMoney:
+		if t.Kind() != reflect.String && !(implements(t, stringerType) && implements(t, textUnmarshalerType)) {
+			return fmt.Errorf("money field must be a string or implement fmt.Stringer and encoding.TextUnmarshaler")
+		}
+	case kindUUID:
+		if t != uuidType {
+			return fmt.Errorf("uuid field must be uuid.UUID")
+		}
+	case kindOptional:
+		if t.Kind() != reflect.Ptr {
+			return fmt.Errorf("optional field must be a pointer")
+		}
+	case kindVector:
+		if t.Kind() != reflect.Slice {
+			return fmt.Errorf("vector field must be a slice")
+		}
+	case kindVariant:
+		if !implements(t, marshallerType) || !implements(t, unmarshallerType) {
+			return fmt.Errorf("variant field must implement TransactionMarshaller and TransactionUnmarshaller")
+		}
+	}
+	return nil
+}
+
+// EncodeStruct encodes the fields of the struct v tagged with `scorum:"index[,modifier]"`, see TagName.
+func (encoder *Encoder) EncodeStruct(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return fmt.Errorf("encoder: nil %s", rv.Type())
+		}
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("encoder: struct expected, %s found", rv.Type())
+	}
+
+	if !rv.CanAddr() {
+		// the copy makes the pointer receiver marshallers of the fields available
+		addr := reflect.New(rv.Type())
+		addr.Elem().Set(rv)
+		rv = addr.Elem()
+	}
+	return encoder.encodeStruct(rv)
+}
+
+func (encoder *Encoder) encodeStruct(rv reflect.Value) error {
+	fields, err := structFields(rv.Type())
+	if err != nil {
+		return fmt.Errorf("encoder: %w", err)
+	}
+
+	for _, f := range fields {
+		if err := encoder.encodeValue(rv.Field(f.field), f.kind); err != nil {
+			return fmt.Errorf("%s: %w", f.name, err)
+		}
+	}
+	return nil
+}
+
+func (encoder *Encoder) encodeValue(v reflect.Value, kind fieldKind) error {
+	switch kind {
+	case kindMoney:
+		if v.Kind() == reflect.String {
+			return encoder.EncodeMoney(v.String())
+		}
+		return encoder.EncodeMoney(addressable(v).Interface().(fmt.Stringer).String())
+
+	case kindOptional:
+		if v.IsNil() {
+			return encoder.EncodeBool(false)
+		}
+		if err := encoder.EncodeBool(true); err != nil {
+			return err
+		}
+		return encoder.encodeValue(v.Elem(), kindDefault)
+
+	case kindVector:
+		return encoder.encodeVector(v)
+
+	case kindVariant:
+		m, ok := marshaller(v)
+		if !ok || v.Kind() == reflect.Ptr && v.IsNil() {
+			return fmt.Errorf("encoder: nil variant %s", v.Type())
+		}
+		return m.MarshalTransaction(encoder)
+	}
+
+	if m, ok := marshaller(v); ok {
+		return m.MarshalTransaction(encoder)
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		return encoder.EncodeBool(v.Bool())
+
+	case reflect.Int8:
+		return encoder.EncodeInt8(int8(v.Int()))
+	case reflect.Int16:
+		return encoder.EncodeInt16(int16(v.Int()))
+	case reflect.Int32:
+		return encoder.EncodeInt32(int32(v.Int()))
+	case reflect.Int64:
+		return encoder.EncodeInt64(v.Int())
+	case reflect.Uint8:
+		return encoder.EncodeUint8(uint8(v.Uint()))
+	case reflect.Uint16:
+		return encoder.EncodeUint16(uint16(v.Uint()))
+	case reflect.Uint32:
+		return encoder.EncodeUint32(uint32(v.Uint()))
+	case reflect.Uint64:
+		return encoder.EncodeUint64(v.Uint())
+
+	case reflect.String:
+		return encoder.EncodeString(v.String())
+
+	case reflect.Slice:
+		return encoder.encodeVector(v)
+
+	case reflect.Ptr:
+		if v.IsNil() {
+			return fmt.Errorf("encoder: nil %s, use optional modifier for the optional fields", v.Type())
+		}
+		return encoder.encodeValue(v.Elem(), kindDefault)
+	}
+
+	if v.Type() == uuidType {
+		if v.CanAddr() {
+			// the pointer doesn't allocate unlike the array copied into interface{}
+			return encoder.EncodeUUID(*v.Addr().Interface().(*uuid.UUID))
+		}
+		return encoder.EncodeUUID(v.Interface().(uuid.UUID))
+	}
+
+	if v.Kind() == reflect.Struct {
+		return encoder.encodeStruct(v)
+	}
+
+	return fmt.Errorf("encoder: unsupported type %s", v.Type())
+}
+
+func (encoder *Encoder) encodeVector(v reflect.Value) error {
+	if err := encoder.EncodeUVarint(uint64(v.Len())); err != nil {
+		return err
+	}
+	for i := 0; i < v.Len(); i++ {
+		if err := encoder.encodeValue(v.Index(i), kindDefault); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeStruct decodes the fields of the struct pointed by v tagged with `scorum:"index[,modifier]"`, see TagName.
+func (decoder *Decoder) DecodeStruct(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("decoder: pointer to struct expected, %T found", v)
+	}
+	return decoder.decodeStruct(rv.Elem())
+}
+
+func (decoder *Decoder) decodeStruct(rv reflect.Value) error {
+	fields, err := structFields(rv.Type())
+	if err != nil {
+		return fmt.Errorf("decoder: %w", err)
+	}
+
+	for _, f := range fields {
+		if err := decoder.decodeValue(rv.Field(f.field), f.kind); err != nil {
+			return fmt.Errorf("%s: %w", f.name, err)
+		}
+	}
+	return nil
+}
+
+// decodeValue decodes into the addressable value v.
+func (decoder *Decoder) decodeValue(v reflect.Value, kind fieldKind) error {
+	switch kind {
+	case kindMoney:
+		money, err := decoder.DecodeMoney()
+		if err != nil {
+			return err
+		}
+		if v.Kind() == reflect.String {
+			v.SetString(money)
+			return nil
+		}
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(money))
+
+	case kindOptional:
+		present, err := decoder.DecodeBool()
+		if err != nil || !present {
+			v.Set(reflect.Zero(v.Type()))
+			return err
+		}
+		v.Set(reflect.New(v.Type().Elem()))
+		return decoder.decodeValue(v.Elem(), kindDefault)
+
+	case kindVector:
+		return decoder.decodeVector(v)
+
+	case kindVariant:
+		if v.Kind() == reflect.Ptr {
+			v.Set(reflect.New(v.Type().Elem()))
+			return v.Interface().(TransactionUnmarshaller).UnmarshalTransaction(decoder)
+		}
+		return v.Addr().Interface().(TransactionUnmarshaller).UnmarshalTransaction(decoder)
+	}
+
+	if u, ok := v.Addr().Interface().(TransactionUnmarshaller); ok {
+		return u.UnmarshalTransaction(decoder)
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		b, err := decoder.DecodeBool()
+		v.SetBool(b)
+		return err
+
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n := reflect.New(basicType(v.Kind()))
+		if err := decoder.DecodeNumber(n.Interface()); err != nil {
+			return err
+		}
+		v.Set(n.Elem().Convert(v.Type()))
+		return nil
+
+	case reflect.String:
+		s, err := decoder.DecodeString()
+		v.SetString(s)
+		return err
+
+	case reflect.Slice:
+		return decoder.decodeVector(v)
+
+	case reflect.Ptr:
+		v.Set(reflect.New(v.Type().Elem()))
+		return decoder.decodeValue(v.Elem(), kindDefault)
+	}
+
+	if v.Type() == uuidType {
+		id, err := decoder.DecodeUUID()
+		v.Set(reflect.ValueOf(id))
+		return err
+	}
+
+	if v.Kind() == reflect.Struct {
+		return decoder.decodeStruct(v)
+	}
+
+	return fmt.Errorf("decoder: unsupported type %s", v.Type())
+}
+
+func (decoder *Decoder) decodeVector(v reflect.Value) error {
+	n, err := decoder.DecodeLength()
+	if err != nil || n == 0 {
+		v.Set(reflect.Zero(v.Type()))
+		return err
+	}
+	s := reflect.MakeSlice(v.Type(), n, n)
+	for i := 0; i < n; i++ {
+		if err := decoder.decodeValue(s.Index(i), kindDefault); err != nil {
+			return err
+		}
+	}
+	v.Set(s)
+	return nil
+}
+
+// marshaller returns the TransactionMarshaller implemented either by the value or by the pointer to it.
+// The method sets are checked first as copying a value which is not a marshaller into interface{} allocates.
+func marshaller(v reflect.Value) (TransactionMarshaller, bool) {
+	if v.CanAddr() && reflect.PtrTo(v.Type()).Implements(marshallerType) {
+		return v.Addr().Interface().(TransactionMarshaller), true
+	}
+	if !v.Type().Implements(marshallerType) || v.Kind() == reflect.Interface && v.IsNil() {
+		return nil, false
+	}
+	return v.Interface().(TransactionMarshaller), true
+}
+
+// addressable returns the pointer to v if possible, so the pointer receiver methods are available.
+func addressable(v reflect.Value) reflect.Value {
+	if v.CanAddr() {
+		return v.Addr()
+	}
+	return v
+}
+
+func basicType(kind reflect.Kind) reflect.Type {
+	switch kind {
+	case reflect.Int8:
+		return reflect.TypeOf(int8(0))
+	case reflect.Int16:
+		return reflect.TypeOf(int16(0))
+	case reflect.Int32:
+		return reflect.TypeOf(int32(0))
+	case reflect.Int64:
+		return reflect.TypeOf(int64(0))
+	case reflect.Uint8:
+		return reflect.TypeOf(uint8(0))
+	case reflect.Uint16:
+		return reflect.TypeOf(uint16(0))
+	case reflect.Uint32:
+		return reflect.TypeOf(uint32(0))
+	default:
+		return reflect.TypeOf(uint64(0))
+	}
+}
@@ -0,0 +1,252 @@
+package transaction
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+type testAmount struct {
+	value string
+}
+
+func (a testAmount) String() string {
+	return a.value
+}
+
+func (a *testAmount) UnmarshalText(text []byte) error {
+	a.value = string(text)
+	return nil
+}
+
+type testVariant struct {
+	Index uint8
+	Value string
+}
+
+func (v *testVariant) MarshalTransaction(encoder *Encoder) error {
+	enc := NewRollingEncoder(encoder)
+	enc.EncodeUVarint(uint64(v.Index))
+	enc.Encode(v.Value)
+	return enc.Err()
+}
+
+func (v *testVariant) UnmarshalTransaction(decoder *Decoder) error {
+	dec := NewRollingDecoder(decoder)
+	v.Index = uint8(dec.DecodeUVarint())
+	dec.Decode(&v.Value)
+	return dec.Err()
+}
+
+type testNested struct {
+	Weight uint16 `scorum:"1"`
+	Name   string `scorum:"0"`
+}
+
+type testStruct struct {
+	Skipped  string
+	Account  string      `json:"account" scorum:"1"`
+	ID       uuid.UUID   `scorum:"0,uuid"`
+	Fee      string      `scorum:"2,money"`
+	Amount   testAmount  `scorum:"3,money"`
+	Weight   int16       `scorum:"4"`
+	Approved bool        `scorum:"5"`
+	Nested   testNested  `scorum:"6"`
+	Items    []uint16    `scorum:"7,vector"`
+	Memo     *string     `scorum:"8,optional"`
+	Variant  testVariant `scorum:"9,variant"`
+}
+
+func TestEncoder_EncodeStruct(t *testing.T) {
+	memo := "memo"
+	v := testStruct{
+		Skipped:  "skipped",
+		Account:  "alice",
+		ID:       uuid.MustParse("e629f9aa-6b2c-46aa-8fa8-36770e7a7a5f"),
+		Fee:      "1.000000000 SCR",
+		Amount:   testAmount{value: "0.000000002 SP"},
+		Weight:   -2,
+		Approved: true,
+		Nested:   testNested{Name: "bob", Weight: 7},
+		Items:    []uint16{1, 2},
+		Memo:     &memo,
+		Variant:  testVariant{Index: 1, Value: "x"},
+	}
+
+	var b bytes.Buffer
+	require.NoError(t, NewEncoder(&b).EncodeStruct(&v))
+
+	var expected bytes.Buffer
+	enc := NewRollingEncoder(NewEncoder(&expected))
+	enc.EncodeUUID(v.ID)
+	enc.Encode("alice")
+	enc.EncodeMoney("1.000000000 SCR")
+	enc.EncodeMoney("0.000000002 SP")
+	enc.Encode(int16(-2))
+	enc.EncodeBool(true)
+	enc.Encode("bob")
+	enc.Encode(uint16(7))
+	enc.EncodeUVarint(2)
+	enc.Encode(uint16(1))
+	enc.Encode(uint16(2))
+	enc.EncodeBool(true)
+	enc.Encode("memo")
+	enc.EncodeUVarint(1)
+	enc.Encode("x")
+	require.NoError(t, enc.Err())
+
+	require.Equal(t, hex.EncodeToString(expected.Bytes()), hex.EncodeToString(b.Bytes()))
+
+	t.Run("value", func(t *testing.T) {
+		var bv bytes.Buffer
+		require.NoError(t, NewEncoder(&bv).EncodeStruct(v))
+		require.Equal(t, b.Bytes(), bv.Bytes())
+	})
+}
+
+func TestDecoder_DecodeStruct(t *testing.T) {
+	memo := "memo"
+	for name, v := range map[string]testStruct{
+		"optional present": {
+			Account:  "alice",
+			ID:       uuid.MustParse("e629f9aa-6b2c-46aa-8fa8-36770e7a7a5f"),
+			Fee:      "1.000000000 SCR",
+			Amount:   testAmount{value: "0.000000002 SP"},
+			Weight:   -2,
+			Approved: true,
+			Nested:   testNested{Name: "bob", Weight: 7},
+			Items:    []uint16{1, 2},
+			Memo:     &memo,
+			Variant:  testVariant{Index: 1, Value: "x"},
+		},
+		"optional missing": {
+			Account: "alice",
+			Fee:     "0.000000000 SCR",
+			Amount:  testAmount{value: "0.000000000 SCR"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var b bytes.Buffer
+			require.NoError(t, NewEncoder(&b).EncodeStruct(&v))
+
+			var decoded testStruct
+			require.NoError(t, NewDecoder(&b).DecodeStruct(&decoded))
+			require.Equal(t, v, decoded)
+			require.Zero(t, b.Len())
+		})
+	}
+}
+
+func TestDecoder_DecodeStruct_Truncated(t *testing.T) {
+	var b bytes.Buffer
+	require.NoError(t, NewEncoder(&b).EncodeStruct(&testNested{Name: "bob", Weight: 7}))
+
+	var decoded testNested
+	err := NewDecoder(bytes.NewReader(b.Bytes()[:b.Len()-1])).DecodeStruct(&decoded)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Weight")
+}
+
+func TestEncoder_EncodeStruct_InvalidTags(t *testing.T) {
+	for name, v := range map[string]interface{}{
+		"duplicate index": &struct {
+			A string `scorum:"0"`
+			B string `scorum:"0"`
+		}{},
+		"invalid index": &struct {
+			A string `scorum:"a"`
+		}{},
+		"unknown modifier": &struct {
+			A string `scorum:"0,fixed"`
+		}{},
+		"several modifiers": &struct {
+			A *string `scorum:"0,money,optional"`
+		}{},
+		"money": &struct {
+			A int64 `scorum:"0,money"`
+		}{},
+		"uuid": &struct {
+			A string `scorum:"0,uuid"`
+		}{},
+		"optional": &struct {
+			A string `scorum:"0,optional"`
+		}{},
+		"vector": &struct {
+			A string `scorum:"0,vector"`
+		}{},
+		"variant": &struct {
+			A string `scorum:"0,variant"`
+		}{},
+		"unsupported type": &struct {
+			A float64 `scorum:"0"`
+		}{},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Error(t, NewEncoder(new(bytes.Buffer)).EncodeStruct(v))
+			require.Error(t, NewDecoder(bytes.NewReader(make([]byte, 32))).DecodeStruct(v))
+		})
+	}
+}
+
+func TestEncoder_EncodeStruct_NotStruct(t *testing.T) {
+	require.Error(t, NewEncoder(new(bytes.Buffer)).EncodeStruct("alice"))
+	require.Error(t, NewEncoder(new(bytes.Buffer)).EncodeStruct((*testNested)(nil)))
+	require.Error(t, NewDecoder(new(bytes.Buffer)).DecodeStruct(testNested{}))
+}
+
+// testCompactItems has its own encoding which the vector modifier must bypass.
+type testCompactItems []uint16
+
+func (items testCompactItems) MarshalTransaction(encoder *Encoder) error {
+	return encoder.EncodeUint8(uint8(len(items)))
+}
+
+func (items *testCompactItems) UnmarshalTransaction(decoder *Decoder) error {
+	var n uint8
+	if err := decoder.DecodeNumber(&n); err != nil {
+		return err
+	}
+	*items = make(testCompactItems, n)
+	return nil
+}
+
+func TestEncodeStruct_Vector(t *testing.T) {
+	type vectors struct {
+		Own    testCompactItems `scorum:"0"`
+		Vector testCompactItems `scorum:"1,vector"`
+	}
+
+	v := vectors{Own: testCompactItems{1, 2}, Vector: testCompactItems{1, 2}}
+
+	var b bytes.Buffer
+	require.NoError(t, NewEncoder(&b).EncodeStruct(&v))
+	require.Equal(t, "02"+"0201000200", hex.EncodeToString(b.Bytes()))
+
+	var got vectors
+	require.NoError(t, NewDecoder(&b).DecodeStruct(&got))
+	require.Equal(t, testCompactItems{0, 0}, got.Own)
+	require.Equal(t, v.Vector, got.Vector)
+}
+
+func TestEncodeStruct_Variant(t *testing.T) {
+	type variants struct {
+		Variant *testVariant `scorum:"0,variant"`
+	}
+
+	v := variants{Variant: &testVariant{Index: 2, Value: "y"}}
+
+	var b bytes.Buffer
+	require.NoError(t, NewEncoder(&b).EncodeStruct(&v))
+	require.Equal(t, "02"+"0179", hex.EncodeToString(b.Bytes()))
+
+	var got variants
+	require.NoError(t, NewDecoder(&b).DecodeStruct(&got))
+	require.Equal(t, v, got)
+
+	err := NewEncoder(new(bytes.Buffer)).EncodeStruct(&variants{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "nil variant")
+}
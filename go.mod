@@ -3,7 +3,6 @@ module github.com/scorum/scorum-go
 go 1.20
 
 require (
-	github.com/bitly/go-simplejson v0.5.0
 	github.com/btcsuite/btcd v0.22.3
 	github.com/btcsuite/btcutil v1.0.3-0.20201208143702-a53e38424cce
 	github.com/davecgh/go-spew v1.1.1
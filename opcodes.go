@@ -0,0 +1,112 @@
+package scorumgo
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/scorum/scorum-go/encoding/transaction"
+	"github.com/scorum/scorum-go/types"
+)
+
+// OpCodeMismatch describes the operation which is encoded by the node with another code.
+type OpCodeMismatch struct {
+	OpType types.OpType
+	Code   uint16
+	Node   uint16
+}
+
+// OpCodeMismatchError is returned by CheckOpCodes when the active code table differs from the node one.
+type OpCodeMismatchError []OpCodeMismatch
+
+func (e OpCodeMismatchError) Error() string {
+	msgs := make([]string, len(e))
+	for i, m := range e {
+		msgs[i] = fmt.Sprintf("%s: %d, node: %d", m.OpType, m.Code, m.Node)
+	}
+	return "operation codes mismatch: " + strings.Join(msgs, "; ")
+}
+
+// UseChainOpCodes selects the operation code table of the hardfork the node is running.
+// It should be called on start and after the chain is upgraded to keep the transactions signed correctly.
+// The code table is process-wide, see types.UseOpCodes: it is changed for every client,
+// so the clients of the nodes running different hardforks can't be used in the same process.
+func (client *Client) UseChainOpCodes(ctx context.Context) (*types.OpCodeTable, error) {
+	props, err := client.Chain.GetChainProperties(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get chain properties: %w", err)
+	}
+
+	table, err := types.UseHardforkVersion(props.HFVersion)
+	if err != nil {
+		return nil, fmt.Errorf("use hardfork %s: %w", props.HFVersion, err)
+	}
+	return table, nil
+}
+
+// CheckOpCodes asks the node to serialize a transaction with each of the given operations
+// and compares the operation codes the node uses with the active code table.
+// The operations must be valid enough to be parsed by the node, they are not broadcasted.
+func (client *Client) CheckOpCodes(ctx context.Context, ops ...types.Operation) error {
+	var mismatches OpCodeMismatchError
+
+	for _, op := range ops {
+		tx := &types.Transaction{
+			Expiration: types.Epoch,
+			Operations: types.OperationsArray{op},
+		}
+
+		txHex, err := client.Database.GetTransactionHex(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("get transaction hex of %s: %w", op.Type(), err)
+		}
+
+		nodeCode, err := operationCodeFromHex(txHex)
+		if err != nil {
+			return fmt.Errorf("parse transaction hex of %s: %w", op.Type(), err)
+		}
+
+		if code, ok := types.ActiveOpCodes().Code(op.Type()); !ok || code != nodeCode {
+			mismatches = append(mismatches, OpCodeMismatch{OpType: op.Type(), Code: code, Node: nodeCode})
+		}
+	}
+
+	if len(mismatches) > 0 {
+		return mismatches
+	}
+	return nil
+}
+
+// operationCodeFromHex reads the code of the first operation of the hex encoded transaction.
+func operationCodeFromHex(txHex string) (uint16, error) {
+	b, err := hex.DecodeString(txHex)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		refBlockNum    uint16
+		refBlockPrefix uint32
+		expiration     uint32
+	)
+
+	dec := transaction.NewRollingDecoder(transaction.NewDecoder(bytes.NewReader(b)))
+	dec.Decode(&refBlockNum)
+	dec.Decode(&refBlockPrefix)
+	dec.Decode(&expiration)
+	if n := dec.DecodeLength(); dec.Err() == nil && n == 0 {
+		return 0, errors.New("no operations found")
+	}
+	code := dec.DecodeUVarint()
+	if err := dec.Err(); err != nil {
+		return 0, err
+	}
+
+	if uint64(uint16(code)) != code {
+		return 0, fmt.Errorf("invalid operation code %d", code)
+	}
+	return uint16(code), nil
+}
@@ -0,0 +1,85 @@
+package scorumgo
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/scorum/scorum-go/apis/chain"
+	"github.com/scorum/scorum-go/encoding/transaction"
+	"github.com/scorum/scorum-go/types"
+)
+
+// opCodesCaller serves get_transaction_hex encoding the operations with the node codes.
+type opCodesCaller struct {
+	hfVersion string
+	nodeCodes map[types.OpType]uint16
+}
+
+func (c *opCodesCaller) Call(ctx context.Context, api string, method string, args []interface{}, reply interface{}) error {
+	switch method {
+	case "get_chain_properties":
+		reply.(*chain.ChainProperties).HFVersion = c.hfVersion
+	case "get_transaction_hex":
+		tx := args[0].(*types.Transaction)
+
+		var b bytes.Buffer
+		if err := tx.MarshalTransaction(transaction.NewEncoder(&b)); err != nil {
+			return err
+		}
+
+		data := b.Bytes()
+		if code, ok := c.nodeCodes[tx.Operations[0].Type()]; ok {
+			// ref block num, ref block prefix, expiration and operations count go before the code
+			data[11] = byte(code)
+		}
+		*reply.(*string) = hex.EncodeToString(data)
+	}
+	return nil
+}
+
+func (c *opCodesCaller) SetCallback(api string, method string, callback func(raw json.RawMessage)) error {
+	return nil
+}
+
+func (c *opCodesCaller) Close() error {
+	return nil
+}
+
+func TestClient_CheckOpCodes(t *testing.T) {
+	ops := []types.Operation{
+		&types.VoteOperation{Voter: "alice", Author: "bob", Permlink: "post", Weight: 100},
+		&types.AccountWitnessProxyOperation{Account: "alice", Proxy: "bob"},
+	}
+
+	t.Run("match", func(t *testing.T) {
+		client := NewClient(&opCodesCaller{})
+		require.NoError(t, client.CheckOpCodes(context.Background(), ops...))
+	})
+
+	t.Run("mismatch", func(t *testing.T) {
+		client := NewClient(&opCodesCaller{nodeCodes: map[types.OpType]uint16{types.AccountWitnessProxyOpType: 12}})
+
+		err := client.CheckOpCodes(context.Background(), ops...)
+		require.Equal(t, OpCodeMismatchError{
+			{OpType: types.AccountWitnessProxyOpType, Code: 11, Node: 12},
+		}, err)
+	})
+}
+
+func TestClient_UseChainOpCodes(t *testing.T) {
+	defer types.UseOpCodes(types.ActiveOpCodes())
+
+	client := NewClient(&opCodesCaller{hfVersion: "0.5.1"})
+	table, err := client.UseChainOpCodes(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, table, types.ActiveOpCodes())
+
+	client = NewClient(&opCodesCaller{hfVersion: "unknown"})
+	_, err = client.UseChainOpCodes(context.Background())
+	require.Error(t, err)
+}
@@ -0,0 +1,133 @@
+package scorumgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/scorum/scorum-go/key"
+	"github.com/scorum/scorum-go/sign"
+	"github.com/scorum/scorum-go/types"
+)
+
+var (
+	ErrNotRecoveryAccount         = errors.New("not a recovery account of the account to recover")
+	ErrNoRecoveryRequest          = errors.New("there is no pending recovery request")
+	ErrRecoveryRequestExpired     = errors.New("recovery request has expired")
+	ErrNewOwnerKeyMismatch        = errors.New("new owner key does not match the recovery request")
+	ErrRecentOwnerKeyNotInHistory = errors.New("recent owner key is not found in the owner history")
+)
+
+// NewKeyAuthority creates a single key authority with weight threshold 1.
+func NewKeyAuthority(pub *key.PublicKey) types.Authority {
+	return types.Authority{
+		WeightThreshold: 1,
+		AccountAuths:    types.NewAccountAuthorityMap(),
+		KeyAuths:        types.NewKeyAuthorityMap(types.KeyAuthority{Key: types.PublicKey(pub.String()), Weight: 1}),
+	}
+}
+
+// RequestAccountRecovery checks that recoveryAccount is the recovery partner of the account to recover and
+// broadcasts request_account_recovery, it has to be signed with the recovery account active key.
+func (client *Client) RequestAccountRecovery(ctx context.Context, chainID []byte, recoveryAccount, accountToRecover string, newOwner types.Authority, recoveryKey *key.PrivateKey) error {
+	accounts, err := client.Database.GetAccounts(ctx, accountToRecover)
+	if err != nil {
+		return fmt.Errorf("get accounts: %w", err)
+	}
+
+	if len(accounts) == 0 {
+		return fmt.Errorf("account %s is not found", accountToRecover)
+	}
+
+	if accounts[0].RecoveryAccount != recoveryAccount {
+		return ErrNotRecoveryAccount
+	}
+
+	ops := []types.Operation{
+		&types.RequestAccountRecoveryOperation{
+			RecoveryAccount:   recoveryAccount,
+			AccountToRecover:  accountToRecover,
+			NewOwnerAuthority: newOwner,
+		},
+	}
+
+	if _, err := client.BroadcastTransactionSynchronous(ctx, chainID, ops, recoveryKey); err != nil {
+		return fmt.Errorf("broadcast request account recovery: %w", err)
+	}
+
+	return nil
+}
+
+// RecoverAccount completes the pending recovery request. It returns the recover_account transaction
+// signed with both the new owner key and the recent owner key, the latter one must be found in the owner history
+// among the authorities which were valid within SCORUM_OWNER_AUTH_RECOVERY_PERIOD.
+// The transaction is ready to be broadcasted with NetworkBroadcast before the request expires.
+func (client *Client) RecoverAccount(ctx context.Context, chainID []byte, account string, newOwnerKey, recentOwnerKey *key.PrivateKey) (*sign.SignedTransaction, error) {
+	request, err := client.Database.GetRecoveryRequest(ctx, account)
+	if err != nil {
+		return nil, fmt.Errorf("get recovery request: %w", err)
+	}
+
+	if request == nil {
+		return nil, ErrNoRecoveryRequest
+	}
+
+	props, err := client.Chain.GetChainProperties(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get chain properties: %w", err)
+	}
+
+	// the node removes the request as soon as the head block time reaches its expiration
+	if !props.Time.Before(request.Expires.Time) {
+		return nil, ErrRecoveryRequestExpired
+	}
+
+	if !authorityHasKey(request.NewOwnerAuthority, newOwnerKey.PublicKey()) {
+		return nil, ErrNewOwnerKeyMismatch
+	}
+
+	config, err := client.Database.GetConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get config: %w", err)
+	}
+
+	history, err := client.Database.GetOwnerHistory(ctx, account)
+	if err != nil {
+		return nil, fmt.Errorf("get owner history: %w", err)
+	}
+
+	var recentOwner *types.Authority
+	for _, h := range history {
+		// the owner authority can't be used to recover the account once the recovery period has passed
+		if h.LastValidTime.Add(config.ScorumOwnerAuthRecoveryPeriod).Before(props.Time.Time) {
+			continue
+		}
+
+		if authorityHasKey(h.PreviousOwnerAuthority, recentOwnerKey.PublicKey()) {
+			recentOwner = &h.PreviousOwnerAuthority
+		}
+	}
+
+	if recentOwner == nil {
+		return nil, ErrRecentOwnerKeyNotInHistory
+	}
+
+	ops := []types.Operation{
+		&types.RecoverAccountOperation{
+			AccountToRecover:     account,
+			NewOwnerAuthority:    request.NewOwnerAuthority,
+			RecentOwnerAuthority: *recentOwner,
+		},
+	}
+
+	return client.CreateSignedTransaction(ctx, chainID, ops, newOwnerKey, recentOwnerKey)
+}
+
+func authorityHasKey(auth types.Authority, pub *key.PublicKey) bool {
+	if auth.KeyAuths == nil || auth.KeyAuths.OrderedMap == nil {
+		return false
+	}
+
+	_, ok := auth.KeyAuths.Get(types.PublicKey(pub.String()))
+	return ok
+}
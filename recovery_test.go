@@ -0,0 +1,115 @@
+package scorumgo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/scorum/scorum-go/key"
+	"github.com/scorum/scorum-go/sign"
+	"github.com/scorum/scorum-go/types"
+)
+
+func TestNewKeyAuthority(t *testing.T) {
+	owner, err := key.NewPrivateKey()
+	require.NoError(t, err)
+
+	other, err := key.NewPrivateKey()
+	require.NoError(t, err)
+
+	auth := NewKeyAuthority(owner.PublicKey())
+	require.EqualValues(t, 1, auth.WeightThreshold)
+	require.True(t, authorityHasKey(auth, owner.PublicKey()))
+	require.False(t, authorityHasKey(auth, other.PublicKey()))
+}
+
+func TestClient_RecoverAccount(t *testing.T) {
+	ctx := context.Background()
+
+	newKey := func() *key.PrivateKey {
+		k, err := key.NewPrivateKey()
+		require.NoError(t, err)
+		return k
+	}
+	authorityJSON := func(k *key.PrivateKey) string {
+		b, err := json.Marshal(NewKeyAuthority(k.PublicKey()))
+		require.NoError(t, err)
+		return string(b)
+	}
+
+	newOwner, recentOwner, otherOwner := newKey(), newKey(), newKey()
+	request := fmt.Sprintf(`{"account_to_recover":"alice","new_owner_authority":%s,"expires":"2018-08-04T10:00:00"}`, authorityJSON(newOwner))
+	historyEntry := func(k *key.PrivateKey, lastValid string) string {
+		return fmt.Sprintf(`{"account":"alice","previous_owner_authority":%s,"last_valid_time":%q}`, authorityJSON(k), lastValid)
+	}
+
+	newClient := func(now, request string, history ...string) *Client {
+		cc := newFakeCaller()
+		cc.replyChainTime(now)
+		cc.reply("get_recovery_request", request)
+		// 30 days
+		cc.reply("get_config", `{"SCORUM_OWNER_AUTH_RECOVERY_PERIOD":2592000000000}`)
+		cc.reply("get_owner_history", "["+strings.Join(history, ",")+"]")
+		return NewClient(cc, WithHeadBlockReferenceSign())
+	}
+
+	t.Run("match", func(t *testing.T) {
+		client := newClient("2018-08-03T10:00:00", request,
+			historyEntry(otherOwner, "2018-07-20T10:00:00"),
+			historyEntry(recentOwner, "2018-07-10T10:00:00"),
+		)
+
+		stx, err := client.RecoverAccount(ctx, sign.TestNetChainID, "alice", newOwner, recentOwner)
+		require.NoError(t, err)
+		require.Len(t, stx.Signatures, 2)
+		require.Equal(t, types.OperationsArray{
+			&types.RecoverAccountOperation{
+				AccountToRecover:     "alice",
+				NewOwnerAuthority:    NewKeyAuthority(newOwner.PublicKey()),
+				RecentOwnerAuthority: NewKeyAuthority(recentOwner.PublicKey()),
+			},
+		}, stx.Operations)
+	})
+
+	t.Run("recovery period passed", func(t *testing.T) {
+		client := newClient("2018-08-03T10:00:00", request,
+			historyEntry(recentOwner, "2018-07-04T09:59:59"),
+			historyEntry(otherOwner, "2018-07-20T10:00:00"),
+		)
+
+		_, err := client.RecoverAccount(ctx, sign.TestNetChainID, "alice", newOwner, recentOwner)
+		require.Equal(t, ErrRecentOwnerKeyNotInHistory, err)
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		client := newClient("2018-08-03T10:00:00", request, historyEntry(otherOwner, "2018-07-20T10:00:00"))
+
+		_, err := client.RecoverAccount(ctx, sign.TestNetChainID, "alice", newOwner, recentOwner)
+		require.Equal(t, ErrRecentOwnerKeyNotInHistory, err)
+	})
+
+	t.Run("new owner mismatch", func(t *testing.T) {
+		client := newClient("2018-08-03T10:00:00", request, historyEntry(recentOwner, "2018-07-20T10:00:00"))
+
+		_, err := client.RecoverAccount(ctx, sign.TestNetChainID, "alice", otherOwner, recentOwner)
+		require.Equal(t, ErrNewOwnerKeyMismatch, err)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		client := newClient("2018-08-04T10:00:00", request, historyEntry(recentOwner, "2018-07-20T10:00:00"))
+
+		_, err := client.RecoverAccount(ctx, sign.TestNetChainID, "alice", newOwner, recentOwner)
+		require.Equal(t, ErrRecoveryRequestExpired, err)
+	})
+
+	t.Run("no request", func(t *testing.T) {
+		client := newClient("2018-08-03T10:00:00", "null")
+
+		_, err := client.RecoverAccount(ctx, sign.TestNetChainID, "alice", newOwner, recentOwner)
+		require.Equal(t, ErrNoRecoveryRequest, err)
+	})
+}
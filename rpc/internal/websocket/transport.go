@@ -243,7 +243,10 @@ func (tr *Transport) onNotice(incoming protocol.RPCIncoming) error {
 			return fmt.Errorf("failed to parse callbackID: %w", err)
 		}
 
+		tr.callbackMutex.Lock()
 		notice := tr.callbacks[callbackID]
+		tr.callbackMutex.Unlock()
+
 		if notice == nil {
 			return fmt.Errorf("callback %d is not registered", callbackID)
 		}
@@ -256,14 +259,38 @@ func (tr *Transport) onNotice(incoming protocol.RPCIncoming) error {
 }
 
 func (tr *Transport) SetCallback(api string, method string, notice func(args json.RawMessage)) error {
-	// increase callback id
+	callbackID := tr.registerCallback(notice)
+	return tr.Call(context.Background(), api, method, []interface{}{callbackID}, nil)
+}
+
+// CallWithCallback calls the method with the callback id prepended to args,
+// the callback stays registered until cancel is called.
+func (tr *Transport) CallWithCallback(ctx context.Context, api string, method string, args []interface{}, notice func(args json.RawMessage)) (func(), error) {
+	callbackID := tr.registerCallback(notice)
+	cancel := func() {
+		tr.callbackMutex.Lock()
+		delete(tr.callbacks, callbackID)
+		tr.callbackMutex.Unlock()
+	}
+
+	if err := tr.Call(ctx, api, method, append([]interface{}{callbackID}, args...), nil); err != nil {
+		cancel()
+		return nil, err
+	}
+
+	return cancel, nil
+}
+
+func (tr *Transport) registerCallback(notice func(args json.RawMessage)) uint64 {
 	tr.callbackMutex.Lock()
+	defer tr.callbackMutex.Unlock()
+
+	// increase callback id
 	if tr.callbackID == math.MaxUint64 {
 		tr.callbackID = 0
 	}
 	tr.callbackID++
 	tr.callbacks[tr.callbackID] = notice
-	tr.callbackMutex.Unlock()
 
-	return tr.Call(context.Background(), api, method, []interface{}{tr.callbackID}, nil)
+	return tr.callbackID
 }
@@ -1,7 +1,6 @@
 package sign
 
 import (
-	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
@@ -20,34 +19,31 @@ func NewSignedTransaction(tx *types.Transaction) *SignedTransaction {
 }
 
 func (tx *SignedTransaction) Serialize() ([]byte, error) {
-	var b bytes.Buffer
-	encoder := transaction.NewEncoder(&b)
+	b := transaction.AcquireBuffer()
+	defer transaction.ReleaseBuffer(b)
 
-	if err := encoder.Encode(tx.Transaction); err != nil {
+	if err := b.Encoder().Encode(tx.Transaction); err != nil {
 		return nil, err
 	}
-	return b.Bytes(), nil
+	return append([]byte(nil), b.Bytes()...), nil
 }
 
+// Digest returns the hash of the chain ID followed by the serialized transaction, the signatures sign it.
 func (tx *SignedTransaction) Digest(chainID []byte) ([]byte, error) {
-	var msgBuffer bytes.Buffer
+	b := transaction.AcquireBuffer()
+	defer transaction.ReleaseBuffer(b)
 
-	if _, err := msgBuffer.Write(chainID); err != nil {
+	if _, err := b.Write(chainID); err != nil {
 		return nil, fmt.Errorf("failed to write chain ID: %w", err)
 	}
 
 	// Write the serialized transaction.
-	rawTx, err := tx.Serialize()
-	if err != nil {
+	if err := b.Encoder().Encode(tx.Transaction); err != nil {
 		return nil, err
 	}
 
-	if _, err := msgBuffer.Write(rawTx); err != nil {
-		return nil, fmt.Errorf("failed to write serialized transaction: %w", err)
-	}
-
 	// Compute the digest.
-	digest := sha256.Sum256(msgBuffer.Bytes())
+	digest := sha256.Sum256(b.Bytes())
 	return digest[:], nil
 }
 
@@ -23,7 +23,7 @@ func TestTransaction_Digest(t *testing.T) {
 	tx = &types.Transaction{
 		RefBlockNum:    36029,
 		RefBlockPrefix: 1164960351,
-		Expiration:     &types.Time{&expiration},
+		Expiration:     types.NewTime(expiration),
 	}
 	tx.PushOperation(&types.VoteOperation{
 		Voter:    "xeroc",
@@ -74,3 +74,40 @@ func TestTransaction_Verify(t *testing.T) {
 
 	require.NoError(t, stx.Verify(TestNetChainID, pubKey))
 }
+
+func BenchmarkSignedTransaction_Digest(b *testing.B) {
+	expiration := time.Date(2016, 8, 8, 12, 24, 17, 0, time.UTC)
+	amount, err := types.AssetFromString("1.000000000 SCR")
+	require.NoError(b, err)
+
+	for name, op := range map[string]types.Operation{
+		"vote": &types.VoteOperation{
+			Voter:    "xeroc",
+			Author:   "xeroc",
+			Permlink: "piston",
+			Weight:   10000,
+		},
+		"transfer": &types.TransferOperation{
+			From:   "azucena",
+			To:     "leonarda",
+			Amount: *amount,
+			Memo:   "{\"bet_id\":\"8b022219-3825-413e-a6ae-1cc3154bdb7f\"}",
+		},
+	} {
+		stx := NewSignedTransaction(&types.Transaction{
+			RefBlockNum:    36029,
+			RefBlockPrefix: 1164960351,
+			Expiration:     types.NewTime(expiration),
+			Operations:     types.OperationsArray{op},
+		})
+
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := stx.Digest(zeroChainID); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
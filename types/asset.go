@@ -1,27 +1,81 @@
 package types
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/scorum/scorum-go/encoding/transaction"
 	"github.com/shopspring/decimal"
 )
 
-const Symbol = "SCR"
+// AssetSymbol is the symbol of the chain asset.
+type AssetSymbol string
 
+const (
+	// SCR is the liquid token.
+	SCR AssetSymbol = "SCR"
+	// SP is scorumpower, the vested token.
+	SP AssetSymbol = "SP"
+)
+
+// Symbol is the symbol of the liquid token.
+const Symbol = string(SCR)
+
+// assetPrecisions keeps the number of decimals of the known symbols.
+var assetPrecisions = map[AssetSymbol]uint8{
+	SCR: 9,
+	SP:  9,
+}
+
+var (
+	errAssetSymbolMismatch = errors.New("asset symbols mismatch")
+	errAssetOverflow       = errors.New("asset amount overflow")
+)
+
+// Precision returns the number of decimals of the symbol, false is returned if the symbol is unknown.
+func (s AssetSymbol) Precision() (uint8, bool) {
+	p, ok := assetPrecisions[s]
+	return p, ok
+}
+
+// Asset is the amount of SCR or SP, it is kept the way the chain does:
+// the amount in the smallest units, the precision and the symbol.
+// The zero value is 0 SCR, the equal assets are deeply equal, so they can be compared with reflect.DeepEqual.
 type Asset struct {
-	d decimal.Decimal
+	amount    int64
+	precision uint8
+	symbol    AssetSymbol
 }
 
+// NewAsset creates the asset of the amount given in the smallest units, e.g. 1 SCR is NewAsset(1000000000, 9, SCR).
+func NewAsset(amount int64, precision uint8, symbol AssetSymbol) *Asset {
+	as := newAsset(amount, precision, symbol)
+	return &as
+}
+
+// newAsset keeps SCR of its own precision with the empty symbol and precision,
+// so the asset is represented the same way whether it is parsed or it is the zero value.
+func newAsset(amount int64, precision uint8, symbol AssetSymbol) Asset {
+	if symbol == SCR && precision == assetPrecisions[SCR] {
+		return Asset{amount: amount}
+	}
+	return Asset{amount: amount, precision: precision, symbol: symbol}
+}
+
+// AssetFromDecimal creates the SCR asset, the value is rounded to the SCR precision.
 func AssetFromDecimal(d decimal.Decimal) *Asset {
-	return &Asset{d: d}
+	precision := assetPrecisions[SCR]
+	return NewAsset(d.Shift(int32(precision)).Round(0).IntPart(), precision, SCR)
 }
 
+// AssetFromFloat creates the SCR asset, the value is rounded to the SCR precision.
 func AssetFromFloat(value float64) *Asset {
-	return &Asset{d: decimal.NewFromFloat(value)}
+	return AssetFromDecimal(decimal.NewFromFloat(value))
 }
 
+// AssetFromString parses the asset like "1.000000000 SP", the value without the symbol is considered to be SCR.
 func AssetFromString(value string) (*Asset, error) {
 	var a Asset
 	if err := a.UnmarshalText([]byte(value)); err != nil {
@@ -30,36 +84,213 @@ func AssetFromString(value string) (*Asset, error) {
 	return &a, nil
 }
 
+// normalized returns the asset with the symbol and the precision set, so the zero value becomes 0 SCR.
+func (as Asset) normalized() Asset {
+	if as.symbol == "" {
+		as.symbol = SCR
+		as.precision = assetPrecisions[SCR]
+	}
+	return as
+}
+
+// Amount returns the amount in the smallest units.
+func (as Asset) Amount() int64 {
+	return as.amount
+}
+
+func (as Asset) Precision() uint8 {
+	return as.normalized().precision
+}
+
+func (as Asset) Symbol() AssetSymbol {
+	return as.normalized().symbol
+}
+
 func (as Asset) String() string {
-	return fmt.Sprintf("%s %s", as.d.StringFixed(9), Symbol)
+	as = as.normalized()
+	return formatAssetAmount(as.amount, as.precision) + " " + string(as.symbol)
 }
 
 func (as Asset) Decimal() decimal.Decimal {
-	return as.d
+	as = as.normalized()
+	return decimal.New(as.amount, -int32(as.precision))
+}
+
+func (as Asset) IsZero() bool {
+	return as.amount == 0
+}
+
+func (as Asset) IsPositive() bool {
+	return as.amount > 0
+}
+
+func (as Asset) IsNegative() bool {
+	return as.amount < 0
+}
+
+// Neg returns the asset with the amount negated.
+func (as Asset) Neg() Asset {
+	return newAsset(-as.amount, as.Precision(), as.Symbol())
+}
+
+// Add returns the sum of the assets, the symbols and the precisions must match.
+func (as Asset) Add(other Asset) (Asset, error) {
+	a, b, err := as.pair(other)
+	if err != nil {
+		return Asset{}, err
+	}
+
+	sum := a.amount + b.amount
+	if (sum > a.amount) != (b.amount > 0) {
+		return Asset{}, fmt.Errorf("%s + %s: %w", a, b, errAssetOverflow)
+	}
+	return newAsset(sum, a.precision, a.symbol), nil
+}
+
+// Sub returns the difference of the assets, the symbols and the precisions must match.
+func (as Asset) Sub(other Asset) (Asset, error) {
+	a, b, err := as.pair(other)
+	if err != nil {
+		return Asset{}, err
+	}
+
+	diff := a.amount - b.amount
+	if (diff < a.amount) != (b.amount > 0) {
+		return Asset{}, fmt.Errorf("%s - %s: %w", a, b, errAssetOverflow)
+	}
+	return newAsset(diff, a.precision, a.symbol), nil
+}
+
+// Cmp returns -1, 0 or 1 if the asset is less than, equal or greater than the other one.
+// The assets of the different symbols can't be compared.
+func (as Asset) Cmp(other Asset) (int, error) {
+	a, b, err := as.pair(other)
+	if err != nil {
+		return 0, err
+	}
+
+	switch {
+	case a.amount < b.amount:
+		return -1, nil
+	case a.amount > b.amount:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// Equal reports whether the assets have the same symbol, precision and amount.
+func (as Asset) Equal(other Asset) bool {
+	return as.normalized() == other.normalized()
+}
+
+func (as Asset) pair(other Asset) (Asset, Asset, error) {
+	a, b := as.normalized(), other.normalized()
+	if a.symbol != b.symbol || a.precision != b.precision {
+		return a, b, fmt.Errorf("%w: %s and %s", errAssetSymbolMismatch, a, b)
+	}
+	return a, b, nil
 }
 
-func (as *Asset) MarshalText() (text []byte, err error) {
+func (as Asset) MarshalText() (text []byte, err error) {
 	return []byte(as.String()), nil
 }
 
 func (as *Asset) UnmarshalText(data []byte) error {
 	value := string(data)
-	index := strings.Index(value, Symbol)
-	if index != -1 {
-		if len(value) == len(Symbol)+index {
-			value = value[0 : index-1]
-		} else {
-			return fmt.Errorf("can't convert %s to asset", value)
-		}
+
+	symbol := SCR
+	if i := strings.IndexByte(value, ' '); i != -1 {
+		symbol = AssetSymbol(value[i+1:])
+		value = value[:i]
+	}
+
+	precision, ok := symbol.Precision()
+	if !ok {
+		return fmt.Errorf("can't convert %s to asset: unknown symbol %s", data, symbol)
 	}
-	d, err := decimal.NewFromString(value)
+
+	amount, err := parseAssetAmount(value, precision)
 	if err != nil {
-		return err
+		return fmt.Errorf("can't convert %s to asset: %w", data, err)
 	}
-	as.d = d
+
+	*as = newAsset(amount, precision, symbol)
 	return nil
 }
 
+// MarshalTransaction encodes the asset as the chain does: the amount, the precision and the symbol padded to 7 bytes.
 func (as Asset) MarshalTransaction(encoder *transaction.Encoder) error {
-	return encoder.Encode(as.String())
+	as = as.normalized()
+	return encoder.EncodeAmount(as.amount, as.precision, string(as.symbol))
+}
+
+func (as *Asset) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	amount, precision, symbol, err := decoder.DecodeAmount()
+	if err != nil {
+		return err
+	}
+
+	*as = newAsset(amount, precision, AssetSymbol(symbol))
+	return nil
+}
+
+// parseAssetAmount parses the decimal number like "-1.5" into the smallest units of the given precision.
+func parseAssetAmount(s string, precision uint8) (int64, error) {
+	negative := strings.HasPrefix(s, "-")
+	if negative {
+		s = s[1:]
+	}
+
+	integer, fraction := s, ""
+	if i := strings.IndexByte(s, '.'); i != -1 {
+		integer, fraction = s[:i], s[i+1:]
+	}
+
+	if integer == "" || !isDigits(integer) || !isDigits(fraction) {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+	if len(fraction) > int(precision) {
+		return 0, fmt.Errorf("amount %q exceeds the precision %d", s, precision)
+	}
+
+	digits := integer + fraction + strings.Repeat("0", int(precision)-len(fraction))
+	amount, err := strconv.ParseUint(digits, 10, 63)
+	if err != nil {
+		return 0, errAssetOverflow
+	}
+
+	if negative {
+		return -int64(amount), nil
+	}
+	return int64(amount), nil
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func formatAssetAmount(amount int64, precision uint8) string {
+	var sign string
+	abs := uint64(amount)
+	if amount < 0 {
+		sign = "-"
+		// the negation of math.MinInt64 overflows, but it is still right as unsigned
+		abs = uint64(-amount)
+	}
+
+	digits := strconv.FormatUint(abs, 10)
+	if precision == 0 {
+		return sign + digits
+	}
+
+	p := int(precision)
+	if len(digits) <= p {
+		digits = strings.Repeat("0", p-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-p] + "." + digits[len(digits)-p:]
 }
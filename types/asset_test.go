@@ -1,8 +1,14 @@
 package types
 
 import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"math"
 	"testing"
 
+	"github.com/scorum/scorum-go/encoding/transaction"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 )
 
@@ -37,3 +43,154 @@ func TestAsset_AssertFromString(t *testing.T) {
 	asset, err = AssetFromString("123.56 SCR PTR")
 	require.Error(t, err)
 }
+
+func TestAsset_UnmarshalText(t *testing.T) {
+	for value, expected := range map[string]struct {
+		amount    int64
+		precision uint8
+		symbol    AssetSymbol
+		str       string
+	}{
+		"1 SCR":            {1000000000, 9, SCR, "1.000000000 SCR"},
+		"0.000000001 SP":   {1, 9, SP, "0.000000001 SP"},
+		"1.5 SP":           {1500000000, 9, SP, "1.500000000 SP"},
+		"-2.000000000 SCR": {-2000000000, 9, SCR, "-2.000000000 SCR"},
+		"3.25":             {3250000000, 9, SCR, "3.250000000 SCR"},
+	} {
+		t.Run(value, func(t *testing.T) {
+			asset, err := AssetFromString(value)
+			require.NoError(t, err)
+			require.Equal(t, expected.amount, asset.Amount())
+			require.Equal(t, expected.precision, asset.Precision())
+			require.Equal(t, expected.symbol, asset.Symbol())
+			require.Equal(t, expected.str, asset.String())
+		})
+	}
+
+	for _, value := range []string{
+		"",
+		"SCR",
+		"1.0000000001 SCR",
+		"1.0000000001 SP",
+		"1,5 SCR",
+		".5 SCR",
+		"1.5.0 SCR",
+		"1.5 sp",
+		"1.5  SP",
+		"9223372036854775808 SCR",
+	} {
+		t.Run(value, func(t *testing.T) {
+			_, err := AssetFromString(value)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestAsset_ZeroValue(t *testing.T) {
+	var asset Asset
+	require.Equal(t, "0.000000000 SCR", asset.String())
+	require.Equal(t, SCR, asset.Symbol())
+	require.EqualValues(t, 9, asset.Precision())
+	require.True(t, asset.Equal(*NewAsset(0, 9, SCR)))
+}
+
+func TestAsset_Arithmetic(t *testing.T) {
+	a := mustAsset(t, "1.5 SP")
+	b := mustAsset(t, "0.25 SP")
+
+	sum, err := a.Add(b)
+	require.NoError(t, err)
+	require.Equal(t, "1.750000000 SP", sum.String())
+
+	diff, err := b.Sub(a)
+	require.NoError(t, err)
+	require.Equal(t, "-1.250000000 SP", diff.String())
+	require.True(t, diff.IsNegative())
+	require.Equal(t, "1.250000000 SP", diff.Neg().String())
+
+	cmp, err := a.Cmp(b)
+	require.NoError(t, err)
+	require.Equal(t, 1, cmp)
+
+	cmp, err = b.Cmp(a)
+	require.NoError(t, err)
+	require.Equal(t, -1, cmp)
+
+	cmp, err = a.Cmp(mustAsset(t, "1.500000000 SP"))
+	require.NoError(t, err)
+	require.Equal(t, 0, cmp)
+
+	require.True(t, a.Decimal().Equal(decimal.RequireFromString("1.5")))
+
+	scr := mustAsset(t, "1 SCR")
+	_, err = a.Add(scr)
+	require.ErrorIs(t, err, errAssetSymbolMismatch)
+	_, err = a.Sub(scr)
+	require.ErrorIs(t, err, errAssetSymbolMismatch)
+	_, err = a.Cmp(scr)
+	require.ErrorIs(t, err, errAssetSymbolMismatch)
+	require.False(t, a.Equal(*NewAsset(a.Amount(), 9, SCR)))
+
+	_, err = NewAsset(math.MaxInt64, 9, SP).Add(b)
+	require.ErrorIs(t, err, errAssetOverflow)
+	_, err = NewAsset(math.MinInt64, 9, SP).Sub(b)
+	require.ErrorIs(t, err, errAssetOverflow)
+}
+
+func TestAsset_MarshalTransaction(t *testing.T) {
+	for value, expected := range map[string]string{
+		"1.000000000 SCR": "00ca9a3b000000000953435200000000",
+		"0.000000002 SP":  "02000000000000000953500000000000",
+	} {
+		t.Run(value, func(t *testing.T) {
+			asset := mustAsset(t, value)
+
+			var b bytes.Buffer
+			require.NoError(t, transaction.NewEncoder(&b).Encode(asset))
+			require.Equal(t, expected, hex.EncodeToString(b.Bytes()))
+
+			var decoded Asset
+			require.NoError(t, transaction.NewDecoder(&b).Decode(&decoded))
+			require.Equal(t, asset, decoded)
+		})
+	}
+}
+
+func TestAsset_JSON(t *testing.T) {
+	type amounts struct {
+		Amount      Asset  `json:"amount"`
+		Scorumpower *Asset `json:"scorumpower"`
+	}
+
+	sp := mustAsset(t, "2 SP")
+	b, err := json.Marshal(amounts{Amount: mustAsset(t, "1 SCR"), Scorumpower: &sp})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"amount":"1.000000000 SCR","scorumpower":"2.000000000 SP"}`, string(b))
+
+	var decoded amounts
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	require.Equal(t, mustAsset(t, "1 SCR"), decoded.Amount)
+	require.Equal(t, sp, *decoded.Scorumpower)
+}
+
+func TestAsset_DeepEqual(t *testing.T) {
+	var zero Asset
+	require.Equal(t, zero, mustAsset(t, "0.000000000 SCR"))
+	require.Equal(t, zero, *NewAsset(0, 9, SCR))
+
+	a := mustAsset(t, "1.500000000 SCR")
+
+	sum, err := a.Add(zero)
+	require.NoError(t, err)
+	require.Equal(t, a, sum)
+
+	diff, err := a.Sub(a)
+	require.NoError(t, err)
+	require.Equal(t, zero, diff)
+
+	var decoded Asset
+	b, err := json.Marshal(a)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	require.Equal(t, a, decoded)
+}
@@ -1,13 +1,19 @@
 package types
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"sort"
 
 	"github.com/elliotchance/orderedmap"
 	"github.com/scorum/scorum-go/encoding/transaction"
+	"github.com/scorum/scorum-go/key"
 )
 
+var errInvalidAuthorityMap = errors.New("invalid map encoding")
+
 type Authority struct {
 	WeightThreshold uint32               `json:"weight_threshold"`
 	AccountAuths    *AccountAuthorityMap `json:"account_auths"`
@@ -22,11 +28,24 @@ func (m Authority) MarshalTransaction(encoder *transaction.Encoder) error {
 	return enc.Err()
 }
 
+func (m *Authority) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	m.AccountAuths = &AccountAuthorityMap{}
+	m.KeyAuths = &KeyAuthorityMap{}
+
+	dec := transaction.NewRollingDecoder(decoder)
+	dec.Decode(&m.WeightThreshold)
+	dec.Decode(m.AccountAuths)
+	dec.Decode(m.KeyAuths)
+	return dec.Err()
+}
+
 type KeyAuthority struct {
 	Key    PublicKey
 	Weight uint16
 }
 
+// KeyAuthorityMap is the chain flat_map of the keys to their weights. It is encoded the way the chain does:
+// sorted by the binary form of the keys regardless of the insertion order.
 type KeyAuthorityMap struct {
 	*orderedmap.OrderedMap
 }
@@ -43,65 +62,96 @@ func NewKeyAuthorityMap(items ...KeyAuthority) *KeyAuthorityMap {
 	}
 }
 
+// Items returns the key authorities sorted the way the chain does, the keys which can't be parsed go last.
+func (m *KeyAuthorityMap) Items() []KeyAuthority {
+	if m == nil || m.OrderedMap == nil {
+		return nil
+	}
+
+	type sortable struct {
+		KeyAuthority
+		raw []byte
+	}
+
+	xs := make([]sortable, 0, m.Len())
+	for el := m.Front(); el != nil; el = el.Next() {
+		k, _ := el.Key.(PublicKey)
+		w, _ := el.Value.(uint16)
+
+		x := sortable{KeyAuthority: KeyAuthority{Key: k, Weight: w}}
+		if pub, err := key.NewPublicKey(string(k)); err == nil {
+			x.raw = pub.Serialize()
+		}
+		xs = append(xs, x)
+	}
+
+	sort.SliceStable(xs, func(i, j int) bool {
+		a, b := xs[i], xs[j]
+		if (a.raw == nil) != (b.raw == nil) {
+			return a.raw != nil
+		}
+		if a.raw == nil {
+			return a.Key < b.Key
+		}
+		return bytes.Compare(a.raw, b.raw) < 0
+	})
+
+	items := make([]KeyAuthority, len(xs))
+	for i, x := range xs {
+		items[i] = x.KeyAuthority
+	}
+	return items
+}
+
 func (m *KeyAuthorityMap) MarshalTransaction(encoder *transaction.Encoder) error {
+	items := m.Items()
+
 	enc := transaction.NewRollingEncoder(encoder)
-	enc.Encode(uint8(m.Len()))
-	for el := m.Front(); el != nil; el = el.Next() {
-		enc.Encode(el.Key)
-		enc.Encode(el.Value)
+	enc.EncodeUVarint(uint64(len(items)))
+	for _, item := range items {
+		enc.Encode(item.Key)
+		enc.EncodeUint16(item.Weight)
 	}
 	return enc.Err()
 }
 
+func (m *KeyAuthorityMap) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	dec := transaction.NewRollingDecoder(decoder)
+	m.OrderedMap = orderedmap.NewOrderedMap()
+	for i, n := 0, dec.DecodeLength(); i < n; i++ {
+		var (
+			k PublicKey
+			v uint16
+		)
+		dec.Decode(&k)
+		dec.Decode(&v)
+		m.Set(k, v)
+	}
+	return dec.Err()
+}
+
 func (m KeyAuthorityMap) MarshalJSON() ([]byte, error) {
-	xs := make([]interface{}, 0, m.Len())
-	for el := m.Front(); el != nil; el = el.Next() {
-		xs = append(xs, []interface{}{el.Key, el.Value})
+	items := m.Items()
+
+	xs := make([][2]interface{}, 0, len(items))
+	for _, item := range items {
+		xs = append(xs, [2]interface{}{item.Key, item.Weight})
 	}
 
 	return json.Marshal(xs)
 }
 
 func (m *KeyAuthorityMap) UnmarshalJSON(data []byte) error {
-	var xs [][]interface{}
-	if err := json.Unmarshal(data, &xs); err != nil {
-		return err
-	}
-
 	orderedMap := orderedmap.NewOrderedMap()
 
-	var invalid bool
-	for _, kv := range xs {
-		if len(kv) != 2 {
-			invalid = true
-			break
-		}
-
-		k, ok := kv[0].(string)
-		if !ok {
-			invalid = true
-			break
-		}
-
-		var v uint16
-		switch t := kv[1].(type) {
-		case uint16:
-			v = t
-		case float64:
-			v = uint16(t)
-		default:
-			invalid = true
-			break
-		}
-
-		orderedMap.Set(PublicKey(k), v)
-	}
-	if invalid {
-		return errors.New("invalid map encoding")
+	err := unmarshalAuthorityPairs(data, func(k string, weight uint16) {
+		orderedMap.Set(PublicKey(k), weight)
+	})
+	if err != nil {
+		return err
 	}
 
 	m.OrderedMap = orderedMap
-
 	return nil
 }
 
@@ -110,6 +160,8 @@ type AccountAuthority struct {
 	Weight      uint16
 }
 
+// AccountAuthorityMap is the chain flat_map of the accounts to their weights,
+// it is encoded sorted by the account names regardless of the insertion order.
 type AccountAuthorityMap struct {
 	*orderedmap.OrderedMap
 }
@@ -126,63 +178,100 @@ func NewAccountAuthorityMap(items ...AccountAuthority) *AccountAuthorityMap {
 	}
 }
 
+// Items returns the account authorities sorted by the account names.
+func (m *AccountAuthorityMap) Items() []AccountAuthority {
+	if m == nil || m.OrderedMap == nil {
+		return nil
+	}
+
+	items := make([]AccountAuthority, 0, m.Len())
+	for el := m.Front(); el != nil; el = el.Next() {
+		k, _ := el.Key.(string)
+		w, _ := el.Value.(uint16)
+		items = append(items, AccountAuthority{AccountName: k, Weight: w})
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].AccountName < items[j].AccountName
+	})
+	return items
+}
+
 func (m *AccountAuthorityMap) MarshalTransaction(encoder *transaction.Encoder) error {
+	items := m.Items()
+
 	enc := transaction.NewRollingEncoder(encoder)
-	enc.Encode(uint8(m.Len()))
-	for el := m.Front(); el != nil; el = el.Next() {
-		enc.Encode(el.Key)
-		enc.Encode(el.Value)
+	enc.EncodeUVarint(uint64(len(items)))
+	for _, item := range items {
+		enc.EncodeString(item.AccountName)
+		enc.EncodeUint16(item.Weight)
 	}
 	return enc.Err()
 }
 
+func (m *AccountAuthorityMap) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	dec := transaction.NewRollingDecoder(decoder)
+	m.OrderedMap = orderedmap.NewOrderedMap()
+	for i, n := 0, dec.DecodeLength(); i < n; i++ {
+		var (
+			k string
+			v uint16
+		)
+		dec.Decode(&k)
+		dec.Decode(&v)
+		m.Set(k, v)
+	}
+	return dec.Err()
+}
+
 func (m AccountAuthorityMap) MarshalJSON() ([]byte, error) {
-	xs := make([]interface{}, 0, m.Len())
-	for el := m.Front(); el != nil; el = el.Next() {
-		xs = append(xs, []interface{}{el.Key, el.Value})
+	items := m.Items()
+
+	xs := make([][2]interface{}, 0, len(items))
+	for _, item := range items {
+		xs = append(xs, [2]interface{}{item.AccountName, item.Weight})
 	}
 
 	return json.Marshal(xs)
 }
 
 func (m *AccountAuthorityMap) UnmarshalJSON(data []byte) error {
-	var xs [][]interface{}
-	if err := json.Unmarshal(data, &xs); err != nil {
+	orderedMap := orderedmap.NewOrderedMap()
+
+	err := unmarshalAuthorityPairs(data, func(k string, weight uint16) {
+		orderedMap.Set(k, weight)
+	})
+	if err != nil {
 		return err
 	}
 
-	var invalid bool
-	orderedMap := orderedmap.NewOrderedMap()
-	for _, kv := range xs {
+	m.OrderedMap = orderedMap
+	return nil
+}
+
+// unmarshalAuthorityPairs decodes the flat_map given as [["key", weight], ...].
+func unmarshalAuthorityPairs(data []byte, set func(k string, weight uint16)) error {
+	var pairs [][]json.RawMessage
+	if err := json.Unmarshal(data, &pairs); err != nil {
+		return err
+	}
+
+	for _, kv := range pairs {
 		if len(kv) != 2 {
-			invalid = true
-			break
+			return errInvalidAuthorityMap
 		}
 
-		k, ok := kv[0].(string)
-		if !ok {
-			invalid = true
-			break
+		var (
+			k      string
+			weight uint16
+		)
+		if err := json.Unmarshal(kv[0], &k); err != nil {
+			return fmt.Errorf("%w: %v", errInvalidAuthorityMap, err)
 		}
-
-		var v uint16
-		switch t := kv[1].(type) {
-		case uint16:
-			v = t
-		case float64:
-			v = uint16(t)
-		default:
-			invalid = true
-			break
+		if err := json.Unmarshal(kv[1], &weight); err != nil {
+			return fmt.Errorf("%w: %v", errInvalidAuthorityMap, err)
 		}
-
-		orderedMap.Set(PublicKey(k), v)
+		set(k, weight)
 	}
-	if invalid {
-		return errors.New("invalid map encoding")
-	}
-
-	m.OrderedMap = orderedMap
-
 	return nil
 }
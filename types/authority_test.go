@@ -35,3 +35,38 @@ func TestAccountAuthorityMap(t *testing.T) {
 	require.NoError(t, v.MarshalTransaction(encoder))
 	require.Equal(t, "0105616c6963650100", hex.EncodeToString(b.Bytes()))
 }
+
+func TestKeyAuthorityMap_Order(t *testing.T) {
+	const data = `[["SCR7zPNg5nAsJjP9gvMfQ4UnAwDwf91WPYC8KFzobtMuQ52ns1D6T",1],["SCR5jPZF7PMgTpLqkdfpMu8kXea8Gio6E646aYpTgcjr9qMLrAgnL",2]]`
+
+	var v KeyAuthorityMap
+	require.NoError(t, json.Unmarshal([]byte(data), &v))
+
+	d, err := json.Marshal(v)
+	require.NoError(t, err)
+	require.Equal(t, `[["SCR5jPZF7PMgTpLqkdfpMu8kXea8Gio6E646aYpTgcjr9qMLrAgnL",2],["SCR7zPNg5nAsJjP9gvMfQ4UnAwDwf91WPYC8KFzobtMuQ52ns1D6T",1]]`, string(d))
+
+	// the binary form doesn't depend on the order the keys are added in
+	var a, b bytes.Buffer
+	require.NoError(t, v.MarshalTransaction(transaction.NewEncoder(&a)))
+	reversed := NewKeyAuthorityMap(v.Items()[1], v.Items()[0])
+	require.NoError(t, reversed.MarshalTransaction(transaction.NewEncoder(&b)))
+	require.Equal(t, a.Bytes(), b.Bytes())
+}
+
+func TestAccountAuthorityMap_JSON(t *testing.T) {
+	var v AccountAuthorityMap
+	require.NoError(t, json.Unmarshal([]byte(`[["carol",1],["alice",2],["bob",3]]`), &v))
+
+	d, err := json.Marshal(v)
+	require.NoError(t, err)
+	require.Equal(t, `[["alice",2],["bob",3],["carol",1]]`, string(d))
+
+	d, err = json.Marshal(&AccountAuthorityMap{})
+	require.NoError(t, err)
+	require.Equal(t, `[]`, string(d))
+
+	for _, data := range []string{`[["alice"]]`, `[["alice","1"]]`, `[[1,1]]`, `{}`} {
+		require.Error(t, json.Unmarshal([]byte(data), &v), data)
+	}
+}
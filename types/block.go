@@ -4,40 +4,40 @@ import (
 	"encoding/json"
 	"fmt"
 
-	"github.com/bitly/go-simplejson"
+	"github.com/scorum/scorum-go/encoding/transaction"
 )
 
 type BlockHeader struct {
-	TransactionMerkleRoot string            `json:"transaction_merkle_root"`
-	Previous              string            `json:"previous"`
-	Timestamp             Time              `json:"timestamp"`
-	Witness               string            `json:"witness"`
-	Extensions            []json.RawMessage `json:"extensions"`
+	TransactionMerkleRoot string                `json:"transaction_merkle_root"`
+	Previous              string                `json:"previous"`
+	Timestamp             Time                  `json:"timestamp"`
+	Witness               string                `json:"witness"`
+	Extensions            BlockHeaderExtensions `json:"extensions"`
 }
 
 type Block struct {
-	Previous              string            `json:"previous"`
-	BlockID               string            `json:"block_id"`
-	WitnessSignature      string            `json:"witness_signature"`
-	SigningKey            string            `json:"signing_key"`
-	TransactionIDs        []string          `json:"transaction_ids"`
-	Timestamp             string            `json:"timestamp"`
-	Witness               string            `json:"witness"`
-	TransactionMerkleRoot string            `json:"transaction_merkle_root"`
-	Transactions          []Transaction     `json:"transactions"`
-	Extensions            []json.RawMessage `json:"extensions"`
-	Signatures            []string          `json:"signatures"`
+	Previous              string                `json:"previous"`
+	BlockID               string                `json:"block_id"`
+	WitnessSignature      string                `json:"witness_signature"`
+	SigningKey            string                `json:"signing_key"`
+	TransactionIDs        []string              `json:"transaction_ids"`
+	Timestamp             string                `json:"timestamp"`
+	Witness               string                `json:"witness"`
+	TransactionMerkleRoot string                `json:"transaction_merkle_root"`
+	Transactions          []Transaction         `json:"transactions"`
+	Extensions            BlockHeaderExtensions `json:"extensions"`
+	Signatures            []string              `json:"signatures"`
 }
 
 type OperationsBlock struct {
-	BlockNum              uint32            `json:"block_num"`
-	Previous              string            `json:"previous"`
-	WitnessSignature      string            `json:"witness_signature"`
-	Timestamp             string            `json:"timestamp"`
-	Witness               string            `json:"witness"`
-	TransactionMerkleRoot string            `json:"transaction_merkle_root"`
-	Operations            []OperationInfo   `json:"operations"`
-	Extensions            []json.RawMessage `json:"extensions"`
+	BlockNum              uint32                `json:"block_num"`
+	Previous              string                `json:"previous"`
+	WitnessSignature      string                `json:"witness_signature"`
+	Timestamp             string                `json:"timestamp"`
+	Witness               string                `json:"witness"`
+	TransactionMerkleRoot string                `json:"transaction_merkle_root"`
+	Operations            []OperationInfo       `json:"operations"`
+	Extensions            BlockHeaderExtensions `json:"extensions"`
 }
 
 type OperationInfo struct {
@@ -46,42 +46,161 @@ type OperationInfo struct {
 	TransactionID string
 }
 
-func (oi *OperationInfo) UnmarshalJSON(b []byte) error {
-	j, err := simplejson.NewJson(b)
-	if err != nil {
-		return err
+// operationInfoJSON is the part of the operation object OperationInfo keeps.
+type operationInfoJSON struct {
+	TransactionID string         `json:"trx_id"`
+	Timestamp     Time           `json:"timestamp"`
+	Operation     OperationsFlat `json:"op"`
+}
+
+func (oi OperationInfo) MarshalJSON() ([]byte, error) {
+	v := operationInfoJSON{
+		TransactionID: oi.TransactionID,
+		Timestamp:     oi.Timestamp,
 	}
+	if oi.Operation != nil {
+		v.Operation = OperationsFlat{oi.Operation}
+	}
+	return json.Marshal(v)
+}
 
-	tb, err := j.Get("timestamp").String()
-	if err != nil {
+func (oi *OperationInfo) UnmarshalJSON(b []byte) error {
+	var v operationInfoJSON
+	if err := json.Unmarshal(b, &v); err != nil {
 		return err
 	}
 
-	if err := oi.Timestamp.UnmarshalJSON([]byte(fmt.Sprintf(`"%s"`, tb))); err != nil {
-		return err
+	if len(v.Operation) > 1 {
+		return fmt.Errorf("invalid operation info: expecting a single operation, got %d", len(v.Operation))
 	}
 
-	oi.TransactionID, err = j.Get("trx_id").String()
-	if err != nil {
-		return err
+	*oi = OperationInfo{
+		TransactionID: v.TransactionID,
+		Timestamp:     v.Timestamp,
+	}
+	if len(v.Operation) == 1 {
+		oi.Operation = v.Operation[0]
 	}
+	return nil
+}
+
+// Version is the chain version packed as major << 24 | minor << 16 | patch, in JSON it is "major.minor.patch".
+type Version uint32
 
-	tuple := j.GetPath("op")
+func NewVersion(major, minor uint8, patch uint16) Version {
+	return Version(uint32(major)<<24 | uint32(minor)<<16 | uint32(patch))
+}
+
+func (v Version) Major() uint8 { return uint8(v >> 24) }
+
+func (v Version) Minor() uint8 { return uint8(v >> 16) }
+
+func (v Version) Patch() uint16 { return uint16(v) }
 
-	opType, err := tuple.GetIndex(0).String()
-	if err != nil {
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major(), v.Minor(), v.Patch())
+}
+
+func (v Version) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.String())
+}
+
+func (v *Version) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
 		return err
 	}
 
-	data, err := tuple.GetIndex(1).Encode()
-	if err != nil {
-		return err
+	var (
+		major, minor uint8
+		patch        uint16
+	)
+	if _, err := fmt.Sscanf(s, "%d.%d.%d", &major, &minor, &patch); err != nil {
+		return fmt.Errorf("invalid version %q: %w", s, err)
 	}
 
-	oi.Operation, err = unmarshalOperation(opType, data)
-	if err != nil {
-		return err
+	*v = NewVersion(major, minor, patch)
+	return nil
+}
+
+func (v Version) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encoder.Encode(uint32(v))
+}
+
+func (v *Version) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decoder.DecodeNumber((*uint32)(v))
+}
+
+// HardforkVersionVote is the hardfork the block producer votes for and the time it is proposed to be applied at.
+type HardforkVersionVote struct {
+	HFVersion Version `json:"hf_version"`
+	HFTime    Time    `json:"hf_time"`
+}
+
+func (vote *HardforkVersionVote) MarshalTransaction(encoder *transaction.Encoder) error {
+	enc := transaction.NewRollingEncoder(encoder)
+	enc.Encode(vote.HFVersion)
+	enc.Encode(&vote.HFTime)
+	return enc.Err()
+}
+
+func (vote *HardforkVersionVote) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	dec := transaction.NewRollingDecoder(decoder)
+	dec.Decode(&vote.HFVersion)
+	dec.Decode(&vote.HFTime)
+	return dec.Err()
+}
+
+// Block header extension indexes in the block_header_extensions static variant.
+const (
+	VoidBlockHeaderExtension = iota
+	VersionBlockHeaderExtension
+	HardforkVersionVoteBlockHeaderExtension
+)
+
+var blockHeaderExtensionTypes = staticVariantTypes{
+	VoidBlockHeaderExtension:                {name: "void_t", new: func() interface{} { return &VoidExtension{} }},
+	VersionBlockHeaderExtension:             {name: "version", new: func() interface{} { return new(Version) }},
+	HardforkVersionVoteBlockHeaderExtension: {name: "hardfork_version_vote", new: func() interface{} { return &HardforkVersionVote{} }},
+}
+
+// BlockHeaderExtensions come from the Api in the following form: [[1, "0.1.0"], [2, {"hf_version": "0.2.0", "hf_time": "..."}]]
+type BlockHeaderExtensions []StaticVariant
+
+func (exts BlockHeaderExtensions) MarshalJSON() ([]byte, error) {
+	return marshalStaticVariantsJSON(exts)
+}
+
+func (exts *BlockHeaderExtensions) UnmarshalJSON(b []byte) (err error) {
+	*exts, err = blockHeaderExtensionTypes.unmarshalJSONList(b)
+	return err
+}
+
+func (exts BlockHeaderExtensions) MarshalTransaction(encoder *transaction.Encoder) error {
+	return marshalStaticVariantsTransaction(encoder, exts)
+}
+
+func (exts *BlockHeaderExtensions) UnmarshalTransaction(decoder *transaction.Decoder) (err error) {
+	*exts, err = blockHeaderExtensionTypes.unmarshalTransactionList(decoder)
+	return err
+}
+
+// Version returns the version the block producer runs, false is returned if it is not reported.
+func (exts BlockHeaderExtensions) Version() (Version, bool) {
+	for _, ext := range exts {
+		if v, ok := ext.Value.(*Version); ok {
+			return *v, true
+		}
 	}
+	return 0, false
+}
 
+// HardforkVersionVote returns the hardfork vote of the block producer, nil is returned if there is no vote.
+func (exts BlockHeaderExtensions) HardforkVersionVote() *HardforkVersionVote {
+	for _, ext := range exts {
+		if vote, ok := ext.Value.(*HardforkVersionVote); ok {
+			return vote
+		}
+	}
 	return nil
 }
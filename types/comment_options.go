@@ -0,0 +1,131 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/scorum/scorum-go/encoding/transaction"
+)
+
+// CommentPayoutBeneficiariesExtension is the only comment_options extension known so far,
+// it is the index of the comment_payout_beneficiaries in the comment_options_extension static variant.
+const CommentPayoutBeneficiariesExtension = 0
+
+const commentPayoutBeneficiariesName = "comment_payout_beneficiaries"
+
+// BeneficiaryRoute assigns Weight of the author reward to Account, ScorumOnePercent is 100.
+type BeneficiaryRoute struct {
+	Account string `json:"account"`
+	Weight  uint16 `json:"weight"`
+}
+
+type CommentPayoutBeneficiaries struct {
+	Beneficiaries []BeneficiaryRoute `json:"beneficiaries"`
+}
+
+// Routes returns the beneficiaries sorted by account as the chain requires. Both JSON and binary forms
+// are encoded in this order, so the node rebuilds from JSON the very transaction which has been signed.
+func (b *CommentPayoutBeneficiaries) Routes() []BeneficiaryRoute {
+	if b.Beneficiaries == nil {
+		return nil
+	}
+
+	routes := make([]BeneficiaryRoute, len(b.Beneficiaries))
+	copy(routes, b.Beneficiaries)
+	sort.SliceStable(routes, func(i, j int) bool {
+		return routes[i].Account < routes[j].Account
+	})
+	return routes
+}
+
+func (b CommentPayoutBeneficiaries) MarshalJSON() ([]byte, error) {
+	type beneficiaries CommentPayoutBeneficiaries
+	return json.Marshal(beneficiaries{Beneficiaries: b.Routes()})
+}
+
+func (b *CommentPayoutBeneficiaries) MarshalTransaction(encoder *transaction.Encoder) error {
+	routes := b.Routes()
+
+	enc := transaction.NewRollingEncoder(encoder)
+	enc.EncodeUVarint(uint64(len(routes)))
+	for _, r := range routes {
+		enc.Encode(r.Account)
+		enc.Encode(r.Weight)
+	}
+	return enc.Err()
+}
+
+func (b *CommentPayoutBeneficiaries) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	dec := transaction.NewRollingDecoder(decoder)
+	if n := dec.DecodeLength(); n > 0 {
+		b.Beneficiaries = make([]BeneficiaryRoute, n)
+		for i := range b.Beneficiaries {
+			dec.Decode(&b.Beneficiaries[i].Account)
+			dec.Decode(&b.Beneficiaries[i].Weight)
+		}
+	}
+	return dec.Err()
+}
+
+var commentOptionsExtensionTypes = staticVariantTypes{
+	CommentPayoutBeneficiariesExtension: {
+		name: commentPayoutBeneficiariesName,
+		new:  func() interface{} { return &CommentPayoutBeneficiaries{} },
+	},
+}
+
+// CommentOptionsExtension is the comment_options_extension static variant,
+// in JSON it is represented as [0, {"beneficiaries": [...]}].
+type CommentOptionsExtension struct {
+	Beneficiaries *CommentPayoutBeneficiaries
+}
+
+func (ext CommentOptionsExtension) variant() (StaticVariant, error) {
+	if ext.Beneficiaries == nil {
+		return StaticVariant{}, errors.New("empty comment options extension")
+	}
+	return StaticVariant{Index: CommentPayoutBeneficiariesExtension, Value: ext.Beneficiaries}, nil
+}
+
+func (ext *CommentOptionsExtension) setVariant(v StaticVariant) error {
+	beneficiaries, ok := v.Value.(*CommentPayoutBeneficiaries)
+	if !ok {
+		return fmt.Errorf("unknown comment options extension %d", v.Index)
+	}
+	ext.Beneficiaries = beneficiaries
+	return nil
+}
+
+func (ext CommentOptionsExtension) MarshalJSON() ([]byte, error) {
+	v, err := ext.variant()
+	if err != nil {
+		return nil, err
+	}
+	return v.MarshalJSON()
+}
+
+func (ext *CommentOptionsExtension) UnmarshalJSON(b []byte) error {
+	v, err := commentOptionsExtensionTypes.unmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	return ext.setVariant(v)
+}
+
+func (ext *CommentOptionsExtension) MarshalTransaction(encoder *transaction.Encoder) error {
+	v, err := ext.variant()
+	if err != nil {
+		return err
+	}
+	return v.MarshalTransaction(encoder)
+}
+
+func (ext *CommentOptionsExtension) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	v, err := commentOptionsExtensionTypes.unmarshalTransaction(decoder)
+	if err != nil {
+		return err
+	}
+	return ext.setVariant(v)
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/scorum/scorum-go/encoding/transaction"
+)
+
+// decodeOperation reads the operation code and decodes the operation of the corresponding type.
+func decodeOperation(decoder *transaction.Decoder) (Operation, error) {
+	code, err := decoder.PeekUVarint()
+	if err != nil {
+		return nil, err
+	}
+
+	opType, ok := ActiveOpCodes().OpType(uint16(code))
+	if !ok || uint64(uint16(code)) != code {
+		return nil, fmt.Errorf("unknown operation code %d", code)
+	}
+
+	op, ok := newOperation(opType)
+	if !ok {
+		return nil, fmt.Errorf("operation %s is not supported", opType)
+	}
+
+	unmarshaller, ok := op.(transaction.TransactionUnmarshaller)
+	if !ok {
+		return nil, fmt.Errorf("operation %s can't be decoded", opType)
+	}
+
+	if err := unmarshaller.UnmarshalTransaction(decoder); err != nil {
+		return nil, fmt.Errorf("decode %s: %w", opType, err)
+	}
+
+	return op, nil
+}
+
+// decodeOpCode reads the operation code and checks it matches the operation type.
+func decodeOpCode(dec *transaction.RollingDecoder, opType OpType) {
+	expected, err := opCode(opType)
+	if err != nil {
+		dec.SetErr(err)
+		return
+	}
+
+	code := dec.DecodeUVarint()
+	if dec.Err() == nil && code != uint64(expected) {
+		dec.SetErr(fmt.Errorf("unexpected operation code %d, %s expected", code, opType))
+	}
+}
@@ -0,0 +1,319 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/scorum/scorum-go/encoding/transaction"
+	"github.com/stretchr/testify/require"
+)
+
+func mustAsset(t *testing.T, value string) Asset {
+	asset, err := AssetFromString(value)
+	require.NoError(t, err)
+	return *asset
+}
+
+func testAuthority(key PublicKey) Authority {
+	return Authority{
+		WeightThreshold: 1,
+		AccountAuths:    NewAccountAuthorityMap(AccountAuthority{AccountName: "bob", Weight: 1}),
+		KeyAuths:        NewKeyAuthorityMap(KeyAuthority{Key: key, Weight: 1}),
+	}
+}
+
+func roundTripOperations(t *testing.T) []Operation {
+	var (
+		key1 = PublicKey("SCR7zPNg5nAsJjP9gvMfQ4UnAwDwf91WPYC8KFzobtMuQ52ns1D6T")
+		key2 = PublicKey("SCR5jPZF7PMgTpLqkdfpMu8kXea8Gio6E646aYpTgcjr9qMLrAgnL")
+
+		gameUUID = uuid.MustParse("e629f9aa-6b2c-46aa-8fa8-36770e7a7a5f")
+		betUUID  = uuid.MustParse("13e2b3da-c2c1-4a4d-a4d5-8a3bcd0e3f5c")
+
+		start    = NewTime(time.Date(2018, 8, 3, 10, 12, 43, 0, time.UTC))
+		deadline = NewTime(time.Date(2018, 8, 4, 10, 12, 43, 0, time.UTC))
+
+		markets = []Market{
+			{&YesNoMarket{ID: MarketResultHome}},
+			{&OverUnderMarket{ID: MarketTotal, Threshold: 1000}},
+			{&ScoreYesNoMarket{ID: MarketCorrectScore, Home: 1, Away: 2}},
+		}
+	)
+
+	return []Operation{
+		&VoteOperation{Voter: "alice", Author: "bob", Permlink: "post", Weight: -10000},
+		&CommentOperation{
+			ParentPermlink: "tag",
+			Author:         "alice",
+			Permlink:       "post",
+			Title:          "title",
+			Body:           "body",
+			JsonMetadata:   "{}",
+		},
+		&DeleteCommentOperation{Author: "alice", Permlink: "post"},
+		&CommentOptionsOperation{
+			Author:               "alice",
+			Permlink:             "post",
+			MaxAcceptedPayout:    mustAsset(t, "1000000.000000000 SCR"),
+			PercentSCRs:          10000,
+			AllowVotes:           true,
+			AllowCurationRewards: true,
+			Extensions: []CommentOptionsExtension{{Beneficiaries: &CommentPayoutBeneficiaries{
+				Beneficiaries: []BeneficiaryRoute{{Account: "al", Weight: 500}, {Account: "bob", Weight: 1000}},
+			}}},
+		},
+		&TransferOperation{From: "alice", To: "bob", Amount: mustAsset(t, "1.000000000 SCR"), Memo: "memo"},
+		&TransferToScorumpowerOperation{From: "alice", To: "bob", Amount: mustAsset(t, "1.000000000 SCR")},
+		&BurnOperation{Owner: "alice", Amount: mustAsset(t, "1.000000000 SCR")},
+		&AccountCreateOperation{
+			Fee:            mustAsset(t, "0.050000000 SCR"),
+			Creator:        "alice",
+			NewAccountName: "bob",
+			Owner:          testAuthority(key1),
+			Active:         testAuthority(key2),
+			Posting:        testAuthority(key1),
+			MemoKey:        key2,
+			JsonMetadata:   "{}",
+		},
+		&AccountCreateByCommitteeOperation{
+			Creator:        "alice",
+			NewAccountName: "bob",
+			Owner:          testAuthority(key1),
+			Active:         testAuthority(key2),
+			Posting:        testAuthority(key1),
+			MemoKey:        key2,
+		},
+		&AccountUpdateOperation{
+			Account:      "alice",
+			Owner:        testAuthority(key1),
+			Active:       testAuthority(key2),
+			Posting:      testAuthority(key1),
+			MemoKey:      key2,
+			JsonMetadata: "{}",
+		},
+		&AccountWitnessVoteOperation{Account: "alice", Witness: "bob", Approve: true},
+		&AccountWitnessProxyOperation{Account: "alice", Proxy: "bob"},
+		&WitnessUpdateOperation{
+			Owner:           "alice",
+			Url:             "https://scorum.com",
+			BlockSigningKey: key1,
+			Props: WitnessUpdateOperationProps{
+				AccountCreationFee: mustAsset(t, "0.000750000 SCR"),
+				MaximumBlockSize:   65536,
+			},
+		},
+		&WithdrawScorumpowerOperation{Account: "alice", Scorumpower: mustAsset(t, "1.000000000 SP")},
+		&DelegateScorumpowerOperation{Delegator: "alice", Delegatee: "bob", Scorumpower: mustAsset(t, "1.000000000 SP")},
+		&DelegateSPFromRegPoolOperation{RegCommitteeMember: "alice", Delegatee: "bob", Scorumpower: mustAsset(t, "1.000000000 SP")},
+		&CreateGameOperation{
+			UUID:                gameUUID,
+			Moderator:           "admin",
+			JsonMetadata:        "{}",
+			GameType:            HockeyGameType,
+			StartTime:           start,
+			AutoResolveDelaySec: 33,
+			Markets:             markets,
+		},
+		&UpdateGameMarketsOperation{UUID: gameUUID, Moderator: "admin", Markets: markets},
+		&UpdateGameStartTimeOperation{UUID: gameUUID, Moderator: "admin", StartTime: start},
+		&CancelGameOperation{UUID: gameUUID, Moderator: "admin"},
+		&PostGameResultsOperation{
+			UUID:      gameUUID,
+			Moderator: "admin",
+			Wincases: []Wincase{
+				{&YesNoWincase{ID: WincaseResultHomeYes}},
+				{&OverUnderWincase{ID: WincaseTotalOver, Threshold: 1000}},
+				{&ScoreYesNoWincase{ID: WincaseCorrectScoreYes, Home: 1, Away: 2}},
+			},
+		},
+		&PostBetOperation{
+			UUID:     betUUID,
+			Better:   "alice",
+			GameUUID: gameUUID,
+			Wincase:  Wincase{&OverUnderWincase{ID: WincaseTotalUnder, Threshold: -500}},
+			Odds:     Odds{Numerator: 3, Denominator: 2},
+			Stake:    mustAsset(t, "10.000000000 SCR"),
+			Live:     true,
+		},
+		&CancelPendingBetsOperation{BetIDs: []uuid.UUID{betUUID, gameUUID}, Better: "alice"},
+		&CreateNFTOperation{OwnerAccount: "alice", UUID: betUUID, Name: "nft", JSONMetadata: "{}", InitialPower: 100},
+		&UpdateNFTMetadataOperation{Moderator: "admin", UUID: betUUID, JSONMetadata: "{}"},
+		&AdjustNFTExperienceOperation{Moderator: "admin", UUID: betUUID, Experience: -10},
+		&UpdateNFTNameOperation{Moderator: "admin", UUID: betUUID, Name: "nft"},
+		&CreateGameRoundOperation{Owner: "alice", UUID: betUUID, VerificationKey: "key", Seed: "seed"},
+		&UpdateGameRoundResultOperation{Owner: "alice", UUID: betUUID, Proof: "proof", Vrf: "vrf", Result: 7},
+		&InitiateAtomicswapOperation{
+			Kind:       AtomicswapByInitiator,
+			Owner:      "alice",
+			Recipient:  "bob",
+			Amount:     mustAsset(t, "1.000000000 SCR"),
+			SecretHash: "hash",
+			Metadata:   "meta",
+		},
+		&RedeemAtomicswapOperation{From: "alice", To: "bob", Secret: "secret"},
+		&RefundAtomicswapOperation{Participant: "alice", Initiator: "bob", SecretHash: "hash"},
+		&EscrowTransferOperation{
+			From:                 "alice",
+			To:                   "bob",
+			ScorumAmount:         mustAsset(t, "1.000000000 SCR"),
+			EscrowID:             1,
+			Agent:                "carol",
+			Fee:                  mustAsset(t, "0.100000000 SCR"),
+			JsonMeta:             "{}",
+			RatificationDeadline: start,
+			EscrowExpiration:     deadline,
+		},
+		&EscrowApproveOperation{From: "alice", To: "bob", Agent: "carol", Who: "bob", EscrowID: 1, Approve: true},
+		&EscrowDisputeOperation{From: "alice", To: "bob", Agent: "carol", Who: "bob", EscrowID: 1},
+		&EscrowReleaseOperation{
+			From:         "alice",
+			To:           "bob",
+			Agent:        "carol",
+			Who:          "alice",
+			Receiver:     "bob",
+			EscrowID:     1,
+			ScorumAmount: mustAsset(t, "1.000000000 SCR"),
+		},
+		&ProveAuthorityOperation{Challenged: "alice", RequireOwner: true},
+		&RequestAccountRecoveryOperation{
+			RecoveryAccount:   "alice",
+			AccountToRecover:  "bob",
+			NewOwnerAuthority: testAuthority(key1),
+		},
+		&RecoverAccountOperation{
+			AccountToRecover:     "bob",
+			NewOwnerAuthority:    testAuthority(key1),
+			RecentOwnerAuthority: testAuthority(key2),
+		},
+		&ChangeRecoveryAccountOperation{AccountToRecover: "bob", NewRecoveryAccount: "alice"},
+		&SetWithdrawScorumpowerRouteToAccountOperation{FromAccount: "alice", ToAccount: "bob", Percent: 5000, AutoVest: true},
+		&SetWithdrawScorumpowerRouteToDevPoolOperation{FromAccount: "alice", Percent: 5000, AutoVest: true},
+		&DeclineVotingRightsOperation{Account: "alice", Decline: true},
+		&VoteProposalOperation{VotingAccount: "alice", ProposalID: 42},
+		&CreateProposalOperation{
+			Creator:     "alice",
+			LifetimeSec: 86400,
+			Operation: ProposalOperation{&RegistrationCommitteeChangeQuorumProposal{
+				Quorum:          60,
+				CommitteeQuorum: AddMemberQuorum,
+			}},
+		},
+		&CreateProposalOperation{
+			Creator:     "alice",
+			LifetimeSec: 86400,
+			Operation: ProposalOperation{&DevelopmentCommitteeTransferProposal{
+				Amount:    mustAsset(t, "5.000000000 SCR"),
+				ToAccount: "bob",
+			}},
+		},
+	}
+}
+
+func TestOperations_UnmarshalTransaction(t *testing.T) {
+	ops := roundTripOperations(t)
+
+	for _, op := range ops {
+		op := op
+		t.Run(string(op.Type()), func(t *testing.T) {
+			var b bytes.Buffer
+			require.NoError(t, transaction.NewEncoder(&b).Encode(op))
+
+			decoder := transaction.NewDecoder(&b)
+			got, err := decodeOperation(decoder)
+			require.NoError(t, err)
+			require.Equal(t, op, got)
+			require.NoError(t, decoder.ExpectEOF(), "the whole operation must be consumed")
+		})
+	}
+}
+
+func TestOperations_UnmarshalTransactionImplemented(t *testing.T) {
+	for opType, typ := range knownOperations {
+		op := reflect.New(typ).Interface()
+		if _, ok := op.(transaction.TransactionMarshaller); !ok {
+			continue
+		}
+		_, ok := op.(transaction.TransactionUnmarshaller)
+		require.True(t, ok, "%s can be encoded but can't be decoded", opType)
+	}
+}
+
+func TestTransaction_UnmarshalTransaction(t *testing.T) {
+	expiration := time.Date(2016, 8, 8, 12, 24, 17, 0, time.UTC)
+	tx := Transaction{
+		RefBlockNum:    36029,
+		RefBlockPrefix: 1164960351,
+		Expiration:     NewTime(expiration),
+	}
+	tx.PushOperation(&VoteOperation{
+		Voter:    "xeroc",
+		Author:   "xeroc",
+		Permlink: "piston",
+		Weight:   10000,
+	})
+	tx.PushOperation(&TransferOperation{
+		From:   "alice",
+		To:     "bob",
+		Amount: mustAsset(t, "1.000000000 SCR"),
+	})
+
+	var b bytes.Buffer
+	require.NoError(t, tx.MarshalTransaction(transaction.NewEncoder(&b)))
+	encoded := b.Bytes()
+
+	t.Run("round trip", func(t *testing.T) {
+		var got Transaction
+		require.NoError(t, got.UnmarshalTransaction(transaction.NewDecoder(bytes.NewReader(encoded))))
+		require.Equal(t, tx, got)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		for n := 0; n < len(encoded); n++ {
+			var got Transaction
+			err := got.UnmarshalTransaction(transaction.NewDecoder(bytes.NewReader(encoded[:n])))
+			require.Error(t, err, "%d bytes", n)
+		}
+	})
+
+	t.Run("signed", func(t *testing.T) {
+		signed := tx
+		signed.Signatures = []string{
+			"1f" + strings.Repeat("ab", signatureLength-1),
+			"20" + strings.Repeat("cd", signatureLength-1),
+		}
+
+		var b bytes.Buffer
+		require.NoError(t, signed.MarshalSignedTransaction(transaction.NewEncoder(&b)))
+		require.Equal(t, encoded, b.Bytes()[:len(encoded)], "the signatures follow the transaction")
+
+		got, err := ParseTransactionHex(hex.EncodeToString(b.Bytes()))
+		require.NoError(t, err)
+		require.Equal(t, signed, *got)
+
+		_, err = ParseTransactionHex(hex.EncodeToString(b.Bytes()) + "00")
+		require.Error(t, err, "trailing bytes")
+		_, err = ParseTransactionHex(hex.EncodeToString(b.Bytes()[:b.Len()-1]))
+		require.Error(t, err, "truncated signature")
+
+		// get_transaction_hex of an unsigned transaction ends with the empty signatures
+		got, err = ParseTransactionHex(hex.EncodeToString(encoded) + "00")
+		require.NoError(t, err)
+		require.Equal(t, tx, *got)
+
+		signed.Signatures = []string{"1f00"}
+		require.Error(t, signed.MarshalSignedTransaction(transaction.NewEncoder(&b)))
+	})
+
+	t.Run("unknown operation", func(t *testing.T) {
+		data := append([]byte{}, encoded[:10]...)
+		data = append(data, 1, 0xff, 0x01)
+
+		var got Transaction
+		require.Error(t, got.UnmarshalTransaction(transaction.NewDecoder(bytes.NewReader(data))))
+	})
+}
@@ -3,7 +3,9 @@ package types
 import (
 	"encoding/json"
 	"errors"
-	"reflect"
+	"fmt"
+
+	"github.com/scorum/scorum-go/encoding/transaction"
 )
 
 const (
@@ -22,32 +24,56 @@ var GameTypeNames = map[GameType]string{
 	HockeyGameType: "hockey_game",
 }
 
+// MarshalJSON encodes the game static variant as [name, {}], the game types have no fields.
 func (g GameType) MarshalJSON() ([]byte, error) {
-	var err error
-
-	a := make([]json.RawMessage, 2)
-	a[0], err = json.Marshal(GameTypeNames[g])
-	if err != nil {
-		return nil, err
+	name, ok := GameTypeNames[g]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", errUnsupportedGameType, g)
 	}
-	a[1] = json.RawMessage("{}")
 
-	return json.Marshal(a)
+	return json.Marshal([]interface{}{name, json.RawMessage("{}")})
 }
 
 func (g *GameType) UnmarshalJSON(b []byte) error {
-	var gt []interface{}
-	if err := json.Unmarshal(b, &gt); err != nil {
-		return err
+	name, value, err := unmarshalNamedTuple(b)
+	if err != nil {
+		return fmt.Errorf("invalid game: %w", err)
+	}
+
+	// the game types have no fields, but the value must be an object to be encoded back the same way
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(value, &fields); err != nil || fields == nil || len(fields) != 0 {
+		return fmt.Errorf("invalid game %s: expecting no fields, got %s", name, value)
 	}
 
-	gName := gt[0].(string)
 	for k, v := range GameTypeNames {
-		if v == gName {
-			reflect.Indirect(reflect.ValueOf(g)).SetUint(uint64(k))
+		if v == name {
+			*g = k
 			return nil
 		}
 	}
 
-	return errUnsupportedGameType
+	return fmt.Errorf("%w: %s", errUnsupportedGameType, name)
+}
+
+// MarshalTransaction encodes the game static variant tag, the game types have no fields.
+func (g GameType) MarshalTransaction(encoder *transaction.Encoder) error {
+	if _, ok := GameTypeNames[g]; !ok {
+		return fmt.Errorf("%w: %d", errUnsupportedGameType, g)
+	}
+
+	return encoder.EncodeUVarint(uint64(g))
+}
+
+func (g *GameType) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	tag, err := decoder.DecodeUVarint()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := GameTypeNames[GameType(tag)]; !ok {
+		return fmt.Errorf("%w: %d", errUnsupportedGameType, tag)
+	}
+	*g = GameType(tag)
+	return nil
 }
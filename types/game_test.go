@@ -28,3 +28,26 @@ func TestUnsupportedUnmarshalJSON(t *testing.T) {
 	var game GameType
 	require.Error(t, json.Unmarshal([]byte(testGameUnsupportedJSON), &game))
 }
+
+func TestGameMarshalJSON(t *testing.T) {
+	b, err := json.Marshal(GameType(HockeyGameType))
+	require.NoError(t, err)
+	require.Equal(t, testGameHockeyJSON, string(b))
+
+	_, err = json.Marshal(GameType(7))
+	require.Error(t, err)
+}
+
+func TestMalformedGameUnmarshalJSON(t *testing.T) {
+	var game GameType
+	require.Error(t, json.Unmarshal([]byte(`[]`), &game))
+	require.Error(t, json.Unmarshal([]byte(`[1,{}]`), &game))
+}
+
+func TestGameUnmarshalJSON_Meta(t *testing.T) {
+	var game GameType
+	require.Error(t, json.Unmarshal([]byte(`["soccer_game",null]`), &game))
+	require.Error(t, json.Unmarshal([]byte(`["soccer_game",[]]`), &game))
+	require.Error(t, json.Unmarshal([]byte(`["soccer_game",{"teams":2}]`), &game))
+	require.Error(t, json.Unmarshal([]byte(`["soccer_game",{},{}]`), &game))
+}
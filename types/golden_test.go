@@ -0,0 +1,192 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/scorum/scorum-go/encoding/transaction"
+)
+
+// The golden files keep an operation per file as the node returns it in the transactions and the operation history:
+// [name, {fields}] with the fields in the chain order. Encoding a decoded operation must give the very same JSON.
+// The file is named after the operation, the variants of the same operation are named like "<operation>.<variant>.json".
+// The operations which are broadcasted also have the expected binary form in "<case>.hex",
+// it was produced by the hand-written encoders before the encoding was derived from the struct tags.
+const goldenOperationsDir = "testdata/operations"
+
+type goldenOperation struct {
+	Type   OpType
+	JSON   []byte
+	Binary []byte
+}
+
+// readGoldenOperations returns the golden operations by the case name, which is the file name without the extension.
+func readGoldenOperations(t *testing.T) map[string]goldenOperation {
+	files, err := filepath.Glob(filepath.Join(goldenOperationsDir, "*.json"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	golden := make(map[string]goldenOperation, len(files))
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		require.NoError(t, err)
+
+		var compact bytes.Buffer
+		require.NoError(t, json.Compact(&compact, data), file)
+
+		name := strings.TrimSuffix(filepath.Base(file), ".json")
+		op := goldenOperation{
+			Type: OpType(strings.SplitN(name, ".", 2)[0]),
+			JSON: compact.Bytes(),
+		}
+
+		data, err = os.ReadFile(strings.TrimSuffix(file, ".json") + ".hex")
+		if !os.IsNotExist(err) {
+			require.NoError(t, err)
+			op.Binary, err = hex.DecodeString(strings.TrimSpace(string(data)))
+			require.NoError(t, err, file)
+		}
+
+		golden[name] = op
+	}
+	return golden
+}
+
+func TestGoldenOperations_Coverage(t *testing.T) {
+	golden := readGoldenOperations(t)
+	for opType, typ := range knownOperations {
+		op, ok := golden[string(opType)]
+		require.True(t, ok, "%s has no golden file", opType)
+
+		if _, ok := reflect.New(typ).Interface().(transaction.TransactionMarshaller); ok {
+			require.NotNil(t, op.Binary, "%s has no golden binary", opType)
+		}
+	}
+	for name, op := range golden {
+		require.Contains(t, knownOperations, op.Type, "golden file %s of unknown operation", name)
+	}
+}
+
+func TestGoldenOperations_JSON(t *testing.T) {
+	for name, golden := range readGoldenOperations(t) {
+		name, golden := name, golden
+		t.Run(name, func(t *testing.T) {
+			data := string(golden.JSON)
+
+			var ops OperationsArray
+			require.NoError(t, json.Unmarshal([]byte("["+data+"]"), &ops))
+			require.Len(t, ops, 1)
+
+			op := ops[0]
+			require.Equal(t, golden.Type, op.Type())
+			require.IsType(t, reflect.New(knownOperations[golden.Type]).Interface(), op)
+
+			encoded, err := json.Marshal(ops)
+			require.NoError(t, err)
+			require.Equal(t, "["+data+"]", string(encoded))
+
+			var decoded OperationsArray
+			require.NoError(t, json.Unmarshal(encoded, &decoded))
+			require.Equal(t, ops, decoded)
+		})
+	}
+}
+
+func TestGoldenOperations_Binary(t *testing.T) {
+	for name, golden := range readGoldenOperations(t) {
+		if golden.Binary == nil {
+			continue
+		}
+
+		name, golden := name, golden
+		t.Run(name, func(t *testing.T) {
+			var ops OperationsArray
+			require.NoError(t, json.Unmarshal([]byte("["+string(golden.JSON)+"]"), &ops))
+			op := ops[0]
+
+			var b bytes.Buffer
+			require.NoError(t, transaction.NewEncoder(&b).Encode(op))
+			require.Equal(t, hex.EncodeToString(golden.Binary), hex.EncodeToString(b.Bytes()))
+
+			decoder := transaction.NewDecoder(bytes.NewReader(golden.Binary))
+			got, err := decodeOperation(decoder)
+			require.NoError(t, err)
+			require.Equal(t, op, got)
+			require.NoError(t, decoder.ExpectEOF(), "the whole operation must be consumed")
+		})
+	}
+}
+
+func TestGoldenOperations_Flat(t *testing.T) {
+	golden := readGoldenOperations(t)
+
+	var (
+		items []string
+		ops   OperationsArray
+	)
+	for _, opType := range []OpType{TransferOpType, VoteOpType, CreateGame, PostBet} {
+		data := golden[string(opType)].JSON
+		items = append(items, string(data[1:len(data)-1]))
+
+		var op OperationsArray
+		require.NoError(t, json.Unmarshal([]byte("["+string(data)+"]"), &op))
+		ops = append(ops, op...)
+	}
+	data := "[" + strings.Join(items, ",") + "]"
+
+	var flat OperationsFlat
+	require.NoError(t, json.Unmarshal([]byte(data), &flat))
+	require.Equal(t, []Operation(ops), []Operation(flat))
+
+	encoded, err := json.Marshal(flat)
+	require.NoError(t, err)
+	require.Equal(t, data, string(encoded))
+
+	require.Error(t, json.Unmarshal([]byte(`["transfer"]`), &flat))
+}
+
+func TestGoldenOperations_OperationInfo(t *testing.T) {
+	golden := readGoldenOperations(t)
+
+	for _, opType := range []OpType{TransferOpType, ProducerRewardOpType, BetsMatched} {
+		data := golden[string(opType)].JSON
+		info := `{"trx_id":"8d1a5dd5d2f7b1ee27d40c7d0b7c0e8e4bd3bc23","timestamp":"2018-08-03T10:12:43","op":` + string(data) + `}`
+
+		var decoded OperationInfo
+		require.NoError(t, json.Unmarshal([]byte(info), &decoded), opType)
+		require.Equal(t, opType, decoded.Operation.Type())
+
+		encoded, err := json.Marshal(decoded)
+		require.NoError(t, err)
+		require.Equal(t, info, string(encoded))
+	}
+}
+
+func TestGoldenOperations_Transaction(t *testing.T) {
+	golden := readGoldenOperations(t)
+
+	data := `{"ref_block_num":36029,"ref_block_prefix":1164960351,"expiration":"2018-08-03T10:12:43",` +
+		`"operations":[` + string(golden[string(AccountUpdateOpType)].JSON) + `,` + string(golden[string(CommentOptionsOpType)].JSON) + `,` + string(golden[string(PostBet)].JSON) + `],` +
+		`"extensions":[],` +
+		`"signatures":["1fca978112ca1bbdcafac231b39a23dc4da786eff8147c4e72b9807785afee48bb3e23e8160039594a33894f6564e1b1348bbd7a0088d42c4acb73eeaed59c009d"]}`
+
+	var tx Transaction
+	require.NoError(t, json.Unmarshal([]byte(data), &tx))
+	require.Len(t, tx.Operations, 3)
+
+	encoded, err := json.Marshal(tx)
+	require.NoError(t, err)
+	require.Equal(t, data, string(encoded))
+
+	var decoded Transaction
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	require.Equal(t, tx, decoded)
+}
@@ -17,3 +17,17 @@ func (k PublicKey) MarshalTransaction(encoder *transaction.Encoder) error {
 	enc.Encode(pubKey.Serialize())
 	return enc.Err()
 }
+
+func (k *PublicKey) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	b, err := decoder.DecodeBytes(33)
+	if err != nil {
+		return err
+	}
+
+	pubKey, err := key.PublicKeyFromBytes(b)
+	if err != nil {
+		return err
+	}
+	*k = PublicKey(pubKey.String())
+	return nil
+}
@@ -2,9 +2,10 @@ package types
 
 import (
 	"encoding/json"
-	"github.com/bitly/go-simplejson"
-
 	"errors"
+	"fmt"
+	"math"
+
 	"github.com/scorum/scorum-go/encoding/transaction"
 )
 
@@ -61,70 +62,110 @@ type MarketInterface interface {
 }
 
 func (m Market) MarshalJSON() ([]byte, error) {
+	if m.MarketInterface == nil {
+		return []byte("null"), nil
+	}
 	return json.Marshal(m.MarketInterface)
 }
 
 func (m *Market) UnmarshalJSON(b []byte) error {
-	json, err := simplejson.NewJson(b)
+	if string(b) == "null" {
+		m.MarketInterface = nil
+		return nil
+	}
+
+	name, _, err := unmarshalNamedTuple(b)
 	if err != nil {
 		return err
 	}
 
-	marketName, err := json.GetIndex(0).String()
+	id, ok := marketIDByName(name)
+	if !ok {
+		return fmt.Errorf("%w: %s", errUnknownMarket, name)
+	}
+
+	market, err := newMarket(id)
 	if err != nil {
 		return err
 	}
 
-	marketID := MarketID(-1)
-	for k, v := range MarketNames {
-		if v == marketName {
-			marketID = k
-		}
+	if err := json.Unmarshal(b, market); err != nil {
+		return err
+	}
+	m.MarketInterface = market
+	return nil
+}
+
+// UnmarshalTransaction decodes the market static variant, the market fields depend on the market id.
+func (m *Market) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	id, err := decoder.PeekUVarint()
+	if err != nil {
+		return err
 	}
-	if marketID == -1 {
+	if id > math.MaxInt8 {
 		return errUnknownMarket
 	}
 
-	marketObj := json.GetIndex(1)
+	market, err := newMarket(MarketID(id))
+	if err != nil {
+		return err
+	}
+
+	if err := decoder.Decode(market); err != nil {
+		return err
+	}
+	m.MarketInterface = market
+	return nil
+}
 
-	threshold, err := marketObj.Get("threshold").Int()
-	if err == nil {
-		market := OverUnderMarket{
-			ID:        marketID,
-			Threshold: int16(threshold),
-		}
-		m.MarketInterface = MarketInterface(&market)
-		return nil
+// newMarket creates the empty market of the type the id belongs to.
+func newMarket(id MarketID) (MarketInterface, error) {
+	switch id {
+	case MarketHandicap, MarketTotal, MarketTotalGoalsHome, MarketTotalGoalsAway:
+		return &OverUnderMarket{ID: id}, nil
+	case MarketCorrectScore:
+		return &ScoreYesNoMarket{ID: id}, nil
 	}
 
-	home, err := marketObj.Get("home").Int()
-	if err == nil {
-		away, err := marketObj.Get("away").Int()
-		if err == nil {
-			market := ScoreYesNoMarket{
-				ID:   marketID,
-				Home: uint16(home),
-				Away: uint16(away),
-			}
-			m.MarketInterface = MarketInterface(&market)
-			return nil
+	if _, ok := MarketNames[id]; !ok {
+		return nil, errUnknownMarket
+	}
+	return &YesNoMarket{ID: id}, nil
+}
+
+func marketIDByName(name string) (MarketID, bool) {
+	for id, v := range MarketNames {
+		if v == name {
+			return id, true
 		}
 	}
+	return 0, false
+}
+
+// unmarshalMarket decodes the market given as [name, meta] and returns the market id.
+func unmarshalMarket(b []byte, meta interface{}) (MarketID, error) {
+	name, value, err := unmarshalNamedTuple(b)
+	if err != nil {
+		return 0, err
+	}
 
-	market := YesNoMarket{
-		ID: marketID,
+	id, ok := marketIDByName(name)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", errUnknownMarket, name)
 	}
-	m.MarketInterface = MarketInterface(&market)
 
-	return nil
+	if err := json.Unmarshal(value, meta); err != nil {
+		return 0, err
+	}
+	return id, nil
 }
 
 type MarketID int8
 
 type OverUnderMarket struct {
-	ID MarketID
+	ID MarketID `scorum:"0"`
 
-	Threshold int16
+	Threshold int16 `scorum:"1"`
 }
 
 func (m OverUnderMarket) MarshalJSON() ([]byte, error) {
@@ -144,11 +185,25 @@ func (m OverUnderMarket) MarshalJSON() ([]byte, error) {
 	return json.Marshal(a)
 }
 
+func (op *OverUnderMarket) UnmarshalJSON(b []byte) error {
+	var meta struct {
+		Threshold int16 `json:"threshold"`
+	}
+	id, err := unmarshalMarket(b, &meta)
+	if err != nil {
+		return err
+	}
+
+	*op = OverUnderMarket{ID: id, Threshold: meta.Threshold}
+	return nil
+}
+
 func (op *OverUnderMarket) MarshalTransaction(encoder *transaction.Encoder) error {
-	enc := transaction.NewRollingEncoder(encoder)
-	enc.Encode(uint8(op.ID))
-	enc.Encode(op.Threshold)
-	return enc.Err()
+	return encoder.EncodeStruct(op)
+}
+
+func (op *OverUnderMarket) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decoder.DecodeStruct(op)
 }
 
 func (op *OverUnderMarket) GetName() string {
@@ -170,10 +225,10 @@ func (op *OverUnderMarket) GetMeta() (json.RawMessage, error) {
 }
 
 type ScoreYesNoMarket struct {
-	ID MarketID
+	ID MarketID `scorum:"0"`
 
-	Home uint16
-	Away uint16
+	Home uint16 `scorum:"1"`
+	Away uint16 `scorum:"2"`
 }
 
 func (m ScoreYesNoMarket) MarshalJSON() ([]byte, error) {
@@ -193,12 +248,26 @@ func (m ScoreYesNoMarket) MarshalJSON() ([]byte, error) {
 	return json.Marshal(a)
 }
 
+func (op *ScoreYesNoMarket) UnmarshalJSON(b []byte) error {
+	var meta struct {
+		Home uint16 `json:"home"`
+		Away uint16 `json:"away"`
+	}
+	id, err := unmarshalMarket(b, &meta)
+	if err != nil {
+		return err
+	}
+
+	*op = ScoreYesNoMarket{ID: id, Home: meta.Home, Away: meta.Away}
+	return nil
+}
+
 func (op *ScoreYesNoMarket) MarshalTransaction(encoder *transaction.Encoder) error {
-	enc := transaction.NewRollingEncoder(encoder)
-	enc.Encode(uint8(op.ID))
-	enc.Encode(op.Home)
-	enc.Encode(op.Away)
-	return enc.Err()
+	return encoder.EncodeStruct(op)
+}
+
+func (op *ScoreYesNoMarket) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decoder.DecodeStruct(op)
 }
 
 func (op *ScoreYesNoMarket) GetName() string {
@@ -222,7 +291,7 @@ func (op *ScoreYesNoMarket) GetMeta() (json.RawMessage, error) {
 }
 
 type YesNoMarket struct {
-	ID MarketID
+	ID MarketID `scorum:"0"`
 }
 
 func (m YesNoMarket) MarshalJSON() ([]byte, error) {
@@ -241,10 +310,23 @@ func (m YesNoMarket) MarshalJSON() ([]byte, error) {
 	return json.Marshal(a)
 }
 
+func (op *YesNoMarket) UnmarshalJSON(b []byte) error {
+	var meta struct{}
+	id, err := unmarshalMarket(b, &meta)
+	if err != nil {
+		return err
+	}
+
+	*op = YesNoMarket{ID: id}
+	return nil
+}
+
 func (op *YesNoMarket) MarshalTransaction(encoder *transaction.Encoder) error {
-	enc := transaction.NewRollingEncoder(encoder)
-	enc.Encode(uint8(op.ID))
-	return enc.Err()
+	return encoder.EncodeStruct(op)
+}
+
+func (op *YesNoMarket) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decoder.DecodeStruct(op)
 }
 
 func (op *YesNoMarket) GetName() string {
@@ -110,3 +110,29 @@ func TestYesNoMarket_GetMeta(t *testing.T) {
 	require.NoError(t, err)
 	require.EqualValues(t, testYesNoMeta, meta)
 }
+
+func TestMarket_JSON(t *testing.T) {
+	for id, name := range MarketNames {
+		market, err := newMarket(id)
+		require.NoError(t, err)
+
+		// the zero meta must be resolved by the market id rather than by the fields given
+		data, err := json.Marshal(Market{market})
+		require.NoError(t, err, name)
+
+		var decoded Market
+		require.NoError(t, json.Unmarshal(data, &decoded), name)
+		require.Equal(t, Market{market}, decoded, name)
+	}
+
+	var market Market
+	require.NoError(t, json.Unmarshal([]byte(`null`), &market))
+	require.Nil(t, market.MarketInterface)
+
+	data, err := json.Marshal(market)
+	require.NoError(t, err)
+	require.Equal(t, `null`, string(data))
+
+	require.Error(t, json.Unmarshal([]byte(`["handicap",{"threshold":"500"}]`), &market))
+	require.Error(t, json.Unmarshal([]byte(`["handicap"]`), &market))
+}
@@ -0,0 +1,300 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+var errInvalidHardforkVersion = errors.New("invalid hardfork version")
+
+// OpCodeTable keeps the operation wire codes which are valid starting from the hardfork Version
+// until the hardfork of the next table.
+type OpCodeTable struct {
+	Version string
+
+	codes map[OpType]uint16
+	types map[uint16]OpType
+}
+
+// NewOpCodeTable creates the code table applied since the given hardfork version.
+func NewOpCodeTable(version string, codes map[OpType]uint16) (*OpCodeTable, error) {
+	if _, err := parseHardforkVersion(version); err != nil {
+		return nil, err
+	}
+
+	table := &OpCodeTable{
+		Version: version,
+		codes:   make(map[OpType]uint16, len(codes)),
+		types:   make(map[uint16]OpType, len(codes)),
+	}
+	for opType, code := range codes {
+		if other, ok := table.types[code]; ok {
+			return nil, fmt.Errorf("hardfork %s: operations %s and %s have the same code %d", version, other, opType, code)
+		}
+		table.codes[opType] = code
+		table.types[code] = opType
+	}
+
+	if err := table.Check(); err != nil {
+		return nil, err
+	}
+	return table, nil
+}
+
+func mustOpCodeTable(version string, codes map[OpType]uint16) *OpCodeTable {
+	table, err := NewOpCodeTable(version, codes)
+	if err != nil {
+		panic(err)
+	}
+	return table
+}
+
+// Code returns the code of the operation type, false is returned if the operation is unknown to the hardfork.
+func (t *OpCodeTable) Code(opType OpType) (uint16, bool) {
+	code, ok := t.codes[opType]
+	return code, ok
+}
+
+// OpType returns the operation type of the code, false is returned if the code is unknown to the hardfork.
+func (t *OpCodeTable) OpType(code uint16) (OpType, bool) {
+	opType, ok := t.types[code]
+	return opType, ok
+}
+
+// Check verifies the codes are contiguous starting from 0 as the chain static variant requires.
+func (t *OpCodeTable) Check() error {
+	for code := 0; code < len(t.types); code++ {
+		if _, ok := t.types[uint16(code)]; !ok {
+			return fmt.Errorf("hardfork %s: operation code %d is missing", t.Version, code)
+		}
+	}
+	return nil
+}
+
+// withCode returns the copy of the table with the operation code added.
+func (t *OpCodeTable) withCode(opType OpType, code uint16) (*OpCodeTable, error) {
+	if known, ok := t.codes[opType]; ok {
+		if known != code {
+			return nil, fmt.Errorf("hardfork %s: operation %s has code %d", t.Version, opType, known)
+		}
+		return t, nil
+	}
+	if other, ok := t.types[code]; ok {
+		return nil, fmt.Errorf("hardfork %s: code %d is used by operation %s", t.Version, code, other)
+	}
+
+	table := &OpCodeTable{
+		Version: t.Version,
+		codes:   make(map[OpType]uint16, len(t.codes)+1),
+		types:   make(map[uint16]OpType, len(t.types)+1),
+	}
+	for opType, code := range t.codes {
+		table.codes[opType] = code
+		table.types[code] = opType
+	}
+	table.codes[opType] = code
+	table.types[code] = opType
+
+	return table, nil
+}
+
+// opCodeTables keeps the code tables sorted by the hardfork version.
+// A new table is added only when a hardfork changes the operation codes, which hasn't happened so far:
+// the node replays the blocks of every hardfork with the same operation static variant,
+// so the codes of the operations which are broadcasted never change and the new ones are only appended.
+// The table of the latest hardfork encodes the transactions for any earlier hardfork too,
+// an operation appended later is just rejected by the node which doesn't support it yet.
+// The codes of the virtual operations follow the broadcasted ones and are shifted by the appended operations,
+// but the virtual operations are never encoded: the node returns them as JSON, where they are named.
+var opCodeTables = []*OpCodeTable{
+	mustOpCodeTable("0.0.0", map[OpType]uint16{
+		VoteOpType:                                 0,
+		CommentOpType:                              1,
+		TransferOpType:                             2,
+		TransferToScorumpowerOpType:                3,
+		WithdrawScorumpowerOpType:                  4,
+		AccountCreateByCommitteeOpType:             5,
+		AccountCreateOpType:                        6,
+		AccountCreateWithDelegationOpType:          7,
+		AccountUpdateOpType:                        8,
+		WitnessUpdateOpType:                        9,
+		AccountWitnessVoteOpType:                   10,
+		AccountWitnessProxyOpType:                  11,
+		DeleteCommentOpType:                        12,
+		CommentOptionsOpType:                       13,
+		SetWithdrawScorumpowerRouteToAccount:       14,
+		SetWithdrawScorumpowerRouteToDevPool:       15,
+		ProveAuthority:                             16,
+		RequestAccountRecovery:                     17,
+		RecoverAccount:                             18,
+		ChangeRecoveryAccount:                      19,
+		EscrowApprove:                              20,
+		EscrowDispute:                              21,
+		EscrowRelease:                              22,
+		EscrowTransfer:                             23,
+		DeclineVotingRights:                        24,
+		DelegateScorumpower:                        25,
+		CreateBudget:                               26,
+		CloseBudget:                                27,
+		ProposalVote:                               28,
+		ProposalCreate:                             29,
+		AtomicswapInitiate:                         30,
+		AtomicswapRedeem:                           31,
+		AtomicswapRefund:                           32,
+		CloseBudgetByAdvertisingModeratorOperation: 33,
+		UpdateBudgetOperation:                      34,
+		CreateGame:                                 35,
+		CancelGame:                                 36,
+		UpdateGameMarkets:                          37,
+		UpdateGameStartTime:                        38,
+		PostGameResults:                            39,
+		PostBet:                                    40,
+		CancelPendingBets:                          41,
+		DelegateSPFromRegPool:                      42,
+		CreateNFT:                                  43,
+		UpdateNFTMetadata:                          44,
+		CreateGameRound:                            45,
+		UpdateGameRoundResult:                      46,
+		AdjustNFTExperience:                        47,
+		UpdateNFTName:                              48,
+		BurnOperationOpType:                        49,
+
+		// virtual operations
+		AuthorReward:                         50,
+		CommentBenefactorReward:              51,
+		CommentPayoutUpdate:                  52,
+		CommentReward:                        53,
+		CurationReward:                       54,
+		FillScorumpowerWithdraw:              55,
+		Hardfork:                             56,
+		ProducerRewardOpType:                 57,
+		ReturnScorumpowerDelegation:          58,
+		ShutdownWitness:                      59,
+		WitnessMissBlock:                     60,
+		ExpiredContractRefund:                61,
+		AccFinishedVestingWithdraw:           62,
+		DevpoolFinishedVestingWithdraw:       63,
+		AccToAccVestingWithdraw:              64,
+		DevpoolToAccVestingWithdraw:          65,
+		AccToDevpoolVestingWithdraw:          66,
+		DevpoolToDevpoolVesting:              67,
+		ProposalVirtual:                      68,
+		ActiveSpHoldersRewardLegacy:          69,
+		AllocateCashFromAdvertisingBudget:    70,
+		CashBackFromAdvertisingBudgetToOwner: 71,
+		ClosingBudget:                        72,
+		BetsMatched:                          73,
+		GameStatusChanged:                    74,
+		BetResolved:                          75,
+		BetCancelled:                         76,
+		BetRestored:                          77,
+		BetUpdated:                           78,
+	}),
+}
+
+var activeOpCodes atomic.Value
+
+func init() {
+	activeOpCodes.Store(opCodeTables[len(opCodeTables)-1])
+}
+
+// OpCodesForVersion returns the code table applied at the given hardfork version, e.g. ChainProperties.HFVersion.
+// As the codes have never changed, it is the same table for every hardfork, see opCodeTables.
+func OpCodesForVersion(version string) (*OpCodeTable, error) {
+	v, err := parseHardforkVersion(version)
+	if err != nil {
+		return nil, err
+	}
+
+	// the tables are replaced by RegisterOperation
+	registry.RLock()
+	defer registry.RUnlock()
+
+	i := sort.Search(len(opCodeTables), func(i int) bool {
+		return compareHardforkVersions(mustParseHardforkVersion(opCodeTables[i].Version), v) > 0
+	})
+	if i == 0 {
+		return nil, fmt.Errorf("no operation codes known for hardfork %s", version)
+	}
+	return opCodeTables[i-1], nil
+}
+
+// ActiveOpCodes returns the code table used to encode and decode operations.
+// It is the table of the latest known hardfork unless another one is selected with UseOpCodes.
+func ActiveOpCodes() *OpCodeTable {
+	return activeOpCodes.Load().(*OpCodeTable)
+}
+
+// UseOpCodes selects the code table used to encode and decode operations.
+// The table is process-wide: it is shared by all the clients and the operations encoded concurrently.
+func UseOpCodes(table *OpCodeTable) {
+	activeOpCodes.Store(table)
+}
+
+// UseHardforkVersion selects the code table applied at the given hardfork version.
+func UseHardforkVersion(version string) (*OpCodeTable, error) {
+	table, err := OpCodesForVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	UseOpCodes(table)
+	return table, nil
+}
+
+// opCode returns the code of the operation type in the active code table.
+// The operation unknown to the table can't be encoded, otherwise it would be taken for another operation.
+func opCode(opType OpType) (uint16, error) {
+	table := ActiveOpCodes()
+	code, ok := table.Code(opType)
+	if !ok {
+		return 0, fmt.Errorf("operation %s has no code in the operation codes of hardfork %s", opType, table.Version)
+	}
+	return code, nil
+}
+
+type hardforkVersion []uint64
+
+func parseHardforkVersion(version string) (hardforkVersion, error) {
+	parts := strings.Split(version, ".")
+	v := make(hardforkVersion, len(parts))
+	for i, part := range parts {
+		n, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", errInvalidHardforkVersion, version)
+		}
+		v[i] = n
+	}
+	return v, nil
+}
+
+func mustParseHardforkVersion(version string) hardforkVersion {
+	v, err := parseHardforkVersion(version)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// compareHardforkVersions returns -1, 0 or 1, missing trailing parts are considered to be 0.
+func compareHardforkVersions(a, b hardforkVersion) int {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var x, y uint64
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+	return 0
+}
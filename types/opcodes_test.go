@@ -0,0 +1,122 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/scorum/scorum-go/encoding/transaction"
+)
+
+func TestOpCodeTables(t *testing.T) {
+	for i, table := range opCodeTables {
+		require.NoError(t, table.Check(), table.Version)
+
+		if i > 0 {
+			prev := mustParseHardforkVersion(opCodeTables[i-1].Version)
+			require.Equal(t, 1, compareHardforkVersions(mustParseHardforkVersion(table.Version), prev),
+				"tables must be sorted by version")
+		}
+	}
+}
+
+func TestOpCodeTable_KnownOperations(t *testing.T) {
+	table := ActiveOpCodes()
+	for opType := range knownOperations {
+		_, ok := table.Code(opType)
+		require.True(t, ok, "%s has no code", opType)
+	}
+}
+
+func TestOpCodeTable_Codes(t *testing.T) {
+	table := ActiveOpCodes()
+
+	for opType, code := range map[OpType]uint16{
+		VoteOpType:          0,
+		TransferOpType:      2,
+		ProposalCreate:      29,
+		CreateGame:          35,
+		BurnOperationOpType: 49,
+		AuthorReward:        50,
+		BetUpdated:          78,
+	} {
+		got, ok := table.Code(opType)
+		require.True(t, ok)
+		require.Equal(t, code, got, opType)
+
+		back, ok := table.OpType(code)
+		require.True(t, ok)
+		require.Equal(t, opType, back)
+	}
+
+	_, ok := table.OpType(1000)
+	require.False(t, ok)
+}
+
+func TestNewOpCodeTable(t *testing.T) {
+	t.Run("duplicate code", func(t *testing.T) {
+		_, err := NewOpCodeTable("0.1.0", map[OpType]uint16{VoteOpType: 0, CommentOpType: 0})
+		require.Error(t, err)
+	})
+
+	t.Run("missing code", func(t *testing.T) {
+		_, err := NewOpCodeTable("0.1.0", map[OpType]uint16{VoteOpType: 0, CommentOpType: 2})
+		require.Error(t, err)
+	})
+
+	t.Run("invalid version", func(t *testing.T) {
+		_, err := NewOpCodeTable("v1", map[OpType]uint16{VoteOpType: 0})
+		require.ErrorIs(t, err, errInvalidHardforkVersion)
+	})
+}
+
+func TestOpCodesForVersion(t *testing.T) {
+	defer func(tables []*OpCodeTable) { opCodeTables = tables }(opCodeTables)
+
+	base := opCodeTables[0]
+	next, err := NewOpCodeTable("0.5.0", map[OpType]uint16{CommentOpType: 0, VoteOpType: 1})
+	require.NoError(t, err)
+	opCodeTables = []*OpCodeTable{base, next}
+
+	for version, expected := range map[string]*OpCodeTable{
+		"0.0.0":  base,
+		"0.4.9":  base,
+		"0.5":    next,
+		"0.5.0":  next,
+		"0.10.1": next,
+	} {
+		table, err := OpCodesForVersion(version)
+		require.NoError(t, err, version)
+		require.Equal(t, expected.Version, table.Version, version)
+	}
+
+	_, err = OpCodesForVersion("")
+	require.ErrorIs(t, err, errInvalidHardforkVersion)
+}
+
+func TestUseOpCodes(t *testing.T) {
+	defer UseOpCodes(ActiveOpCodes())
+
+	table, err := NewOpCodeTable("0.5.0", map[OpType]uint16{CommentOpType: 0, VoteOpType: 1})
+	require.NoError(t, err)
+
+	UseOpCodes(table)
+	require.EqualValues(t, 1, VoteOpType.Code())
+	require.EqualValues(t, 0, CommentOpType.Code())
+}
+
+func TestUseOpCodes_UnknownOperation(t *testing.T) {
+	defer UseOpCodes(ActiveOpCodes())
+
+	table, err := NewOpCodeTable("0.5.0", map[OpType]uint16{CommentOpType: 0, VoteOpType: 1})
+	require.NoError(t, err)
+	UseOpCodes(table)
+
+	_, ok := table.Code(TransferOpType)
+	require.False(t, ok)
+
+	op := &TransferOperation{From: "alice", To: "bob", Amount: *NewAsset(1, 9, SCR)}
+	var b bytes.Buffer
+	require.Error(t, transaction.NewEncoder(&b).Encode(op), "the operation unknown to the table must not be encoded as vote")
+}
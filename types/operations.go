@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"reflect"
 
 	"github.com/google/uuid"
@@ -14,6 +15,27 @@ type Operation interface {
 	Type() OpType
 }
 
+// encodeTaggedOperation writes the operation code followed by the operation fields tagged with `scorum`.
+func encodeTaggedOperation(encoder *transaction.Encoder, op Operation) error {
+	code, err := opCode(op.Type())
+	if err != nil {
+		return err
+	}
+
+	enc := transaction.NewRollingEncoder(encoder)
+	enc.EncodeUVarint(uint64(code))
+	enc.EncodeStruct(op)
+	return enc.Err()
+}
+
+// decodeTaggedOperation reads the operation code followed by the operation fields tagged with `scorum`.
+func decodeTaggedOperation(decoder *transaction.Decoder, op Operation) error {
+	dec := transaction.NewRollingDecoder(decoder)
+	decodeOpCode(dec, op.Type())
+	dec.DecodeStruct(op)
+	return dec.Err()
+}
+
 // OperationsArray coming from the Api in the following form: [["op1", {}], ["op2", {}], ...]
 type OperationsArray []Operation
 
@@ -51,128 +73,197 @@ type OperationObject struct {
 }
 
 func (ops *OperationsArray) UnmarshalJSON(b []byte) (err error) {
-	// unmarshal array
-	var o []json.RawMessage
-	if err := json.Unmarshal(b, &o); err != nil {
+	var tuples []json.RawMessage
+	if err := json.Unmarshal(b, &tuples); err != nil {
 		return err
 	}
 
-	// foreach operation
-	for _, op := range o {
-		var kv []json.RawMessage
-		if err := json.Unmarshal(op, &kv); err != nil {
-			return err
-		}
-
-		if len(kv) != 2 {
-			return errors.New("invalid operation format: should be name, value")
-		}
+	if tuples == nil {
+		*ops = nil
+		return nil
+	}
 
-		var key string
-		if err := json.Unmarshal(kv[0], &key); err != nil {
-			return err
+	result := make(OperationsArray, 0, len(tuples))
+	for _, tuple := range tuples {
+		key, data, err := unmarshalNamedTuple(tuple)
+		if err != nil {
+			return fmt.Errorf("invalid operation: %w", err)
 		}
 
-		val, err := unmarshalOperation(key, kv[1])
+		op, err := unmarshalOperation(key, data)
 		if err != nil {
 			return err
 		}
-
-		*ops = append(*ops, val)
+		result = append(result, op)
 	}
 
+	*ops = result
 	return nil
 }
 
 // OperationsFlat coming from the Api in the following form: ["op1", {}, "op2", {}, ...]
 type OperationsFlat []Operation
 
-func (t *OperationsFlat) UnmarshalJSON(b []byte) (err error) {
+func (t OperationsFlat) MarshalJSON() ([]byte, error) {
+	if t == nil {
+		return []byte("null"), nil
+	}
+
+	xs := make([]interface{}, 0, 2*len(t))
+	for _, op := range t {
+		xs = append(xs, op.Type(), op)
+	}
+	return json.Marshal(xs)
+}
 
-	// unmarshal array
+func (t *OperationsFlat) UnmarshalJSON(b []byte) (err error) {
 	var o []json.RawMessage
 	if err := json.Unmarshal(b, &o); err != nil {
 		return err
 	}
 
+	if o == nil {
+		*t = nil
+		return nil
+	}
+	if len(o)%2 != 0 {
+		return errors.New("invalid operations format: should be name, value pairs")
+	}
+
+	result := make(OperationsFlat, 0, len(o)/2)
 	for i := 0; i < len(o); i += 2 {
 		var key string
 		if err := json.Unmarshal(o[i], &key); err != nil {
 			return err
 		}
-		val, err := unmarshalOperation(key, o[i+1])
+
+		op, err := unmarshalOperation(key, o[i+1])
 		if err != nil {
 			return err
 		}
-
-		*t = append(*t, val)
+		result = append(result, op)
 	}
+
+	*t = result
 	return nil
 }
 
 func unmarshalOperation(key string, obj json.RawMessage) (Operation, error) {
-	opType, ok := knownOperations[OpType(key)]
+	val, ok := newOperation(OpType(key))
 	if !ok {
 		// operation is unknown wrap it as a general operation
-		val := UnknownOperation{
-			kind: OpType(key),
+		return &UnknownOperation{
+			Kind: OpType(key),
 			Data: obj,
-		}
-		return &val, nil
-	} else {
-		val := reflect.New(opType).Interface()
-		if err := json.Unmarshal(obj, val); err != nil {
-			return nil, err
-		}
-		return val.(Operation), nil
+		}, nil
 	}
-}
 
-var knownOperations = map[OpType]reflect.Type{
-	AccountCreateOpType:               reflect.TypeOf(AccountCreateOperation{}),
-	TransferToScorumpowerOpType:       reflect.TypeOf(TransferToScorumpowerOperation{}),
-	AccountWitnessVoteOpType:          reflect.TypeOf(AccountWitnessVoteOperation{}),
-	WitnessUpdateOpType:               reflect.TypeOf(WitnessUpdateOperation{}),
-	AccountCreateByCommitteeOpType:    reflect.TypeOf(AccountCreateByCommitteeOperation{}),
-	AccountCreateWithDelegationOpType: reflect.TypeOf(AccountCreateWithDelegationOperation{}),
-	AccountUpdateOpType:               reflect.TypeOf(AccountUpdateOperation{}),
-	TransferOpType:                    reflect.TypeOf(TransferOperation{}),
-	ProducerRewardOpType:              reflect.TypeOf(ProducerRewardOperation{}),
-	CommentOptionsOpType:              reflect.TypeOf(CommentOptionsOperation{}),
-	CommentOpType:                     reflect.TypeOf(CommentOperation{}),
-	DeleteCommentOpType:               reflect.TypeOf(DeleteCommentOperation{}),
-	VoteOpType:                        reflect.TypeOf(VoteOperation{}),
-	WithdrawScorumpowerOpType:         reflect.TypeOf(WithdrawScorumpowerOperation{}),
-	DelegateScorumpower:               reflect.TypeOf(DelegateScorumpowerOperation{}),
-	CreateGame:                        reflect.TypeOf(CreateGameOperation{}),
-	CancelGame:                        reflect.TypeOf(CancelGameOperation{}),
-	UpdateGameStartTime:               reflect.TypeOf(UpdateGameStartTimeOperation{}),
-	PostGameResults:                   reflect.TypeOf(PostGameResultsOperation{}),
-	PostBet:                           reflect.TypeOf(PostBetOperation{}),
-	CancelPendingBets:                 reflect.TypeOf(CancelPendingBetsOperation{}),
-	BetsMatched:                       reflect.TypeOf(BetsMatchedVirtualOperation{}),
-	GameStatusChanged:                 reflect.TypeOf(GameStatusChangedVirtualOperation{}),
-	BetResolved:                       reflect.TypeOf(BetResolvedOperation{}),
-	BetCancelled:                      reflect.TypeOf(BetCancelledOperation{}),
-	DelegateSPFromRegPool:             reflect.TypeOf(DelegateSPFromRegPoolOperation{}),
-	CreateNFT:                         reflect.TypeOf(CreateNFTOperation{}),
-	UpdateNFTMetadata:                 reflect.TypeOf(UpdateNFTMetadataOperation{}),
-	CreateGameRound:                   reflect.TypeOf(CreateGameRoundOperation{}),
-	UpdateGameRoundResult:             reflect.TypeOf(UpdateGameRoundResultOperation{}),
-	AdjustNFTExperience:               reflect.TypeOf(AdjustNFTExperienceOperation{}),
-	UpdateNFTName:                     reflect.TypeOf(UpdateNFTNameOperation{}),
-	BurnOperationOpType:               reflect.TypeOf(BurnOperation{}),
+	if err := json.Unmarshal(obj, val); err != nil {
+		return nil, err
+	}
+	return val, nil
 }
 
+var knownOperations = map[OpType]reflect.Type{
+	AccountCreateOpType:                  reflect.TypeOf(AccountCreateOperation{}),
+	TransferToScorumpowerOpType:          reflect.TypeOf(TransferToScorumpowerOperation{}),
+	AccountWitnessVoteOpType:             reflect.TypeOf(AccountWitnessVoteOperation{}),
+	WitnessUpdateOpType:                  reflect.TypeOf(WitnessUpdateOperation{}),
+	AccountWitnessProxyOpType:            reflect.TypeOf(AccountWitnessProxyOperation{}),
+	AccountCreateByCommitteeOpType:       reflect.TypeOf(AccountCreateByCommitteeOperation{}),
+	AccountCreateWithDelegationOpType:    reflect.TypeOf(AccountCreateWithDelegationOperation{}),
+	AccountUpdateOpType:                  reflect.TypeOf(AccountUpdateOperation{}),
+	TransferOpType:                       reflect.TypeOf(TransferOperation{}),
+	ProducerRewardOpType:                 reflect.TypeOf(ProducerRewardOperation{}),
+	CommentOptionsOpType:                 reflect.TypeOf(CommentOptionsOperation{}),
+	CommentOpType:                        reflect.TypeOf(CommentOperation{}),
+	DeleteCommentOpType:                  reflect.TypeOf(DeleteCommentOperation{}),
+	VoteOpType:                           reflect.TypeOf(VoteOperation{}),
+	WithdrawScorumpowerOpType:            reflect.TypeOf(WithdrawScorumpowerOperation{}),
+	DelegateScorumpower:                  reflect.TypeOf(DelegateScorumpowerOperation{}),
+	CreateGame:                           reflect.TypeOf(CreateGameOperation{}),
+	CancelGame:                           reflect.TypeOf(CancelGameOperation{}),
+	UpdateGameMarkets:                    reflect.TypeOf(UpdateGameMarketsOperation{}),
+	UpdateGameStartTime:                  reflect.TypeOf(UpdateGameStartTimeOperation{}),
+	PostGameResults:                      reflect.TypeOf(PostGameResultsOperation{}),
+	PostBet:                              reflect.TypeOf(PostBetOperation{}),
+	CancelPendingBets:                    reflect.TypeOf(CancelPendingBetsOperation{}),
+	BetsMatched:                          reflect.TypeOf(BetsMatchedVirtualOperation{}),
+	GameStatusChanged:                    reflect.TypeOf(GameStatusChangedVirtualOperation{}),
+	BetResolved:                          reflect.TypeOf(BetResolvedOperation{}),
+	BetCancelled:                         reflect.TypeOf(BetCancelledOperation{}),
+	DelegateSPFromRegPool:                reflect.TypeOf(DelegateSPFromRegPoolOperation{}),
+	CreateNFT:                            reflect.TypeOf(CreateNFTOperation{}),
+	UpdateNFTMetadata:                    reflect.TypeOf(UpdateNFTMetadataOperation{}),
+	CreateGameRound:                      reflect.TypeOf(CreateGameRoundOperation{}),
+	UpdateGameRoundResult:                reflect.TypeOf(UpdateGameRoundResultOperation{}),
+	AdjustNFTExperience:                  reflect.TypeOf(AdjustNFTExperienceOperation{}),
+	UpdateNFTName:                        reflect.TypeOf(UpdateNFTNameOperation{}),
+	BurnOperationOpType:                  reflect.TypeOf(BurnOperation{}),
+	AtomicswapInitiate:                   reflect.TypeOf(InitiateAtomicswapOperation{}),
+	AtomicswapRedeem:                     reflect.TypeOf(RedeemAtomicswapOperation{}),
+	AtomicswapRefund:                     reflect.TypeOf(RefundAtomicswapOperation{}),
+	EscrowTransfer:                       reflect.TypeOf(EscrowTransferOperation{}),
+	EscrowApprove:                        reflect.TypeOf(EscrowApproveOperation{}),
+	EscrowDispute:                        reflect.TypeOf(EscrowDisputeOperation{}),
+	EscrowRelease:                        reflect.TypeOf(EscrowReleaseOperation{}),
+	ProveAuthority:                       reflect.TypeOf(ProveAuthorityOperation{}),
+	RequestAccountRecovery:               reflect.TypeOf(RequestAccountRecoveryOperation{}),
+	RecoverAccount:                       reflect.TypeOf(RecoverAccountOperation{}),
+	ChangeRecoveryAccount:                reflect.TypeOf(ChangeRecoveryAccountOperation{}),
+	ProposalCreate:                       reflect.TypeOf(CreateProposalOperation{}),
+	ProposalVote:                         reflect.TypeOf(VoteProposalOperation{}),
+	ProposalVirtual:                      reflect.TypeOf(ProposalVirtualOperation{}),
+	SetWithdrawScorumpowerRouteToAccount: reflect.TypeOf(SetWithdrawScorumpowerRouteToAccountOperation{}),
+	SetWithdrawScorumpowerRouteToDevPool: reflect.TypeOf(SetWithdrawScorumpowerRouteToDevPoolOperation{}),
+	DeclineVotingRights:                  reflect.TypeOf(DeclineVotingRightsOperation{}),
+	AuthorReward:                         reflect.TypeOf(AuthorRewardOperation{}),
+	CommentBenefactorReward:              reflect.TypeOf(CommentBenefactorRewardOperation{}),
+	CommentPayoutUpdate:                  reflect.TypeOf(CommentPayoutUpdateOperation{}),
+	CommentReward:                        reflect.TypeOf(CommentRewardOperation{}),
+	CurationReward:                       reflect.TypeOf(CurationRewardOperation{}),
+	FillScorumpowerWithdraw:              reflect.TypeOf(FillScorumpowerWithdrawOperation{}),
+	Hardfork:                             reflect.TypeOf(HardforkOperation{}),
+	ReturnScorumpowerDelegation:          reflect.TypeOf(ReturnScorumpowerDelegationOperation{}),
+	ShutdownWitness:                      reflect.TypeOf(ShutdownWitnessOperation{}),
+	WitnessMissBlock:                     reflect.TypeOf(WitnessMissBlockOperation{}),
+	AccFinishedVestingWithdraw:           reflect.TypeOf(AccFinishedVestingWithdrawOperation{}),
+	DevpoolFinishedVestingWithdraw:       reflect.TypeOf(DevpoolFinishedVestingWithdrawOperation{}),
+	AccToAccVestingWithdraw:              reflect.TypeOf(AccToAccVestingWithdrawOperation{}),
+	DevpoolToAccVestingWithdraw:          reflect.TypeOf(DevpoolToAccVestingWithdrawOperation{}),
+	AccToDevpoolVestingWithdraw:          reflect.TypeOf(AccToDevpoolVestingWithdrawOperation{}),
+	DevpoolToDevpoolVesting:              reflect.TypeOf(DevpoolToDevpoolVestingWithdrawOperation{}),
+	BetRestored:                          reflect.TypeOf(BetRestoredOperation{}),
+	BetUpdated:                           reflect.TypeOf(BetUpdatedOperation{}),
+}
+
+// UnknownOperation keeps the operation which is neither built-in nor registered with RegisterOperation.
+// It is marshaled back to the same JSON, but it can't be encoded into the binary form.
 type UnknownOperation struct {
-	kind OpType
+	Kind OpType
 	Data json.RawMessage
 }
 
-func (op *UnknownOperation) Type() OpType { return op.kind }
+func (op *UnknownOperation) Type() OpType { return op.Kind }
+
+func (op *UnknownOperation) MarshalJSON() ([]byte, error) {
+	if op.Data == nil {
+		return []byte("null"), nil
+	}
+	return op.Data, nil
+}
+
+func (op *UnknownOperation) UnmarshalJSON(b []byte) error {
+	op.Data = append(op.Data[:0], b...)
+	return nil
+}
+
+func (op *UnknownOperation) MarshalTransaction(encoder *transaction.Encoder) error {
+	return fmt.Errorf("operation %s is unknown, register it with RegisterOperation to encode it", op.Kind)
+}
 
 type AccountCreateWithDelegationOperation struct {
-	Fee            string            `json:"fee"`
+	Fee            Asset             `json:"fee"`
 	Creator        string            `json:"creator"`
 	NewAccountName string            `json:"new_account_name"`
 	Owner          Authority         `json:"owner"`
@@ -188,123 +279,141 @@ func (op *AccountCreateWithDelegationOperation) Type() OpType {
 }
 
 type AccountCreateByCommitteeOperation struct {
-	Creator        string    `json:"creator"`
-	NewAccountName string    `json:"new_account_name"`
-	Owner          Authority `json:"owner"`
-	Active         Authority `json:"active"`
-	Posting        Authority `json:"posting"`
-	MemoKey        PublicKey `json:"memo_key"`
-	JsonMetadata   string    `json:"json_metadata"`
+	Creator        string    `json:"creator" scorum:"0"`
+	NewAccountName string    `json:"new_account_name" scorum:"1"`
+	Owner          Authority `json:"owner" scorum:"2"`
+	Active         Authority `json:"active" scorum:"3"`
+	Posting        Authority `json:"posting" scorum:"4"`
+	MemoKey        PublicKey `json:"memo_key" scorum:"5"`
+	JsonMetadata   string    `json:"json_metadata" scorum:"6"`
 }
 
 func (op *AccountCreateByCommitteeOperation) Type() OpType { return AccountCreateByCommitteeOpType }
 
 func (op *AccountCreateByCommitteeOperation) MarshalTransaction(encoder *transaction.Encoder) error {
-	enc := transaction.NewRollingEncoder(encoder)
-	enc.EncodeUVarint(uint64(op.Type().Code()))
-	enc.Encode(op.Creator)
-	enc.Encode(op.NewAccountName)
-	enc.Encode(op.Owner)
-	enc.Encode(op.Active)
-	enc.Encode(op.Posting)
-	enc.Encode(op.MemoKey)
-	enc.Encode(op.JsonMetadata)
-	return enc.Err()
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *AccountCreateByCommitteeOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
 }
 
 type TransferToScorumpowerOperation struct {
-	From   string `json:"from"`
-	To     string `json:"to"`
-	Amount string `json:"amount"`
+	From   string `json:"from" scorum:"0"`
+	To     string `json:"to" scorum:"1"`
+	Amount Asset  `json:"amount" scorum:"2"`
 }
 
 func (op *TransferToScorumpowerOperation) Type() OpType { return TransferToScorumpowerOpType }
 
 func (op *TransferToScorumpowerOperation) MarshalTransaction(encoder *transaction.Encoder) error {
-	enc := transaction.NewRollingEncoder(encoder)
-	enc.EncodeUVarint(uint64(op.Type().Code()))
-	enc.Encode(op.From)
-	enc.Encode(op.To)
-	enc.EncodeMoney(op.Amount)
-	return enc.Err()
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *TransferToScorumpowerOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
 }
 
 type AccountCreateOperation struct {
-	Fee            Asset     `json:"fee"`
-	Creator        string    `json:"creator"`
-	NewAccountName string    `json:"new_account_name"`
-	Owner          Authority `json:"owner"`
-	Active         Authority `json:"active"`
-	Posting        Authority `json:"posting"`
-	MemoKey        PublicKey `json:"memo_key"`
-	JsonMetadata   string    `json:"json_metadata"`
+	Fee            Asset     `json:"fee" scorum:"0"`
+	Creator        string    `json:"creator" scorum:"1"`
+	NewAccountName string    `json:"new_account_name" scorum:"2"`
+	Owner          Authority `json:"owner" scorum:"3"`
+	Active         Authority `json:"active" scorum:"4"`
+	Posting        Authority `json:"posting" scorum:"5"`
+	MemoKey        PublicKey `json:"memo_key" scorum:"6"`
+	JsonMetadata   string    `json:"json_metadata" scorum:"7"`
 }
 
 func (op *AccountCreateOperation) Type() OpType { return AccountCreateOpType }
 
 func (op *AccountCreateOperation) MarshalTransaction(encoder *transaction.Encoder) error {
-	enc := transaction.NewRollingEncoder(encoder)
-	enc.EncodeUVarint(uint64(op.Type().Code()))
-	enc.EncodeMoney(op.Fee.String())
-	enc.Encode(op.Creator)
-	enc.Encode(op.NewAccountName)
-	enc.Encode(op.Owner)
-	enc.Encode(op.Active)
-	enc.Encode(op.Posting)
-	enc.Encode(op.MemoKey)
-	enc.Encode(op.JsonMetadata)
-	return enc.Err()
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *AccountCreateOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
 }
 
 type AccountWitnessVoteOperation struct {
-	Account string `json:"account"`
-	Witness string `json:"witness"`
-	Approve bool   `json:"approve"`
+	Account string `json:"account" scorum:"0"`
+	Witness string `json:"witness" scorum:"1"`
+	Approve bool   `json:"approve" scorum:"2"`
 }
 
 func (op *AccountWitnessVoteOperation) Type() OpType { return AccountWitnessVoteOpType }
 
 func (op *AccountWitnessVoteOperation) MarshalTransaction(encoder *transaction.Encoder) error {
-	enc := transaction.NewRollingEncoder(encoder)
-	enc.EncodeUVarint(uint64(op.Type().Code()))
-	enc.Encode(op.Account)
-	enc.Encode(op.Witness)
-	enc.EncodeBool(op.Approve)
-	return enc.Err()
+	return encodeTaggedOperation(encoder, op)
 }
 
+func (op *AccountWitnessVoteOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
+}
+
+// WitnessUpdateOperation creates or updates the owner witness.
+// Unlike Steem there is no registration fee, the operation is signed with the owner active key.
 type WitnessUpdateOperation struct {
-	Owner           string                      `json:"owner"`
-	Url             string                      `json:"url"`
-	BlockSigningKey string                      `json:"block_signing_key"`
-	Props           WitnessUpdateOperationProps `json:"props"`
-	Fee             string                      `json:"fee"`
+	Owner           string                      `json:"owner" scorum:"0"`
+	Url             string                      `json:"url" scorum:"1"`
+	BlockSigningKey PublicKey                   `json:"block_signing_key" scorum:"2"`
+	Props           WitnessUpdateOperationProps `json:"props" scorum:"3"`
 }
 
 func (op *WitnessUpdateOperation) Type() OpType { return WitnessUpdateOpType }
 
+func (op *WitnessUpdateOperation) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *WitnessUpdateOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
+}
+
+// WitnessUpdateOperationProps are the chain properties the witness votes for.
 type WitnessUpdateOperationProps struct {
-	AccountCreationFee string `json:"account_creation_fee"`
-	MaximumBlockSize   int32  `json:"maximum_block_size"`
+	AccountCreationFee Asset  `json:"account_creation_fee" scorum:"0"`
+	MaximumBlockSize   uint32 `json:"maximum_block_size" scorum:"1"`
+}
+
+func (p *WitnessUpdateOperationProps) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encoder.EncodeStruct(p)
+}
+
+func (p *WitnessUpdateOperationProps) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decoder.DecodeStruct(p)
+}
+
+type AccountWitnessProxyOperation struct {
+	Account string `json:"account" scorum:"0"`
+	Proxy   string `json:"proxy" scorum:"1"`
+}
+
+func (op *AccountWitnessProxyOperation) Type() OpType { return AccountWitnessProxyOpType }
+
+func (op *AccountWitnessProxyOperation) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *AccountWitnessProxyOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
 }
 
 type TransferOperation struct {
-	From   string `json:"from"`
-	To     string `json:"to"`
-	Amount Asset  `json:"amount"`
-	Memo   string `json:"memo"`
+	From   string `json:"from" scorum:"0"`
+	To     string `json:"to" scorum:"1"`
+	Amount Asset  `json:"amount" scorum:"2"`
+	Memo   string `json:"memo" scorum:"3"`
 }
 
 func (op *TransferOperation) Type() OpType { return TransferOpType }
 
 func (op *TransferOperation) MarshalTransaction(encoder *transaction.Encoder) error {
-	enc := transaction.NewRollingEncoder(encoder)
-	enc.EncodeUVarint(uint64(op.Type().Code()))
-	enc.Encode(op.From)
-	enc.Encode(op.To)
-	enc.EncodeMoney(op.Amount.String())
-	enc.Encode(op.Memo)
-	return enc.Err()
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *TransferOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
 }
 
 // Equals returns whether the numbers represented by d and d2 are equal.
@@ -316,72 +425,94 @@ func (op TransferOperation) Equals(t2 TransferOperation) bool {
 }
 
 type VoteOperation struct {
-	Voter    string `json:"voter"`
-	Author   string `json:"author"`
-	Permlink string `json:"permlink"`
-	Weight   int16  `json:"weight"`
+	Voter    string `json:"voter" scorum:"0"`
+	Author   string `json:"author" scorum:"1"`
+	Permlink string `json:"permlink" scorum:"2"`
+	Weight   int16  `json:"weight" scorum:"3"`
 }
 
 func (op *VoteOperation) Type() OpType { return VoteOpType }
 
 func (op *VoteOperation) MarshalTransaction(encoder *transaction.Encoder) error {
-	enc := transaction.NewRollingEncoder(encoder)
-	enc.EncodeUVarint(uint64(op.Type().Code()))
-	enc.Encode(op.Voter)
-	enc.Encode(op.Author)
-	enc.Encode(op.Permlink)
-	enc.Encode(op.Weight)
-	return enc.Err()
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *VoteOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
 }
 
 // CommentOperation represents either a new post or a comment.
 // In case Title is filled in and ParentAuthor is empty, it is a new post.
 // The post category can be read from ParentPermlink.
 type CommentOperation struct {
-	ParentAuthor   string `json:"parent_author"`
-	ParentPermlink string `json:"parent_permlink"`
-	Author         string `json:"author"`
-	Permlink       string `json:"permlink"`
-	Title          string `json:"title"`
-	Body           string `json:"body"`
-	JsonMetadata   string `json:"json_metadata"`
+	ParentAuthor   string `json:"parent_author" scorum:"0"`
+	ParentPermlink string `json:"parent_permlink" scorum:"1"`
+	Author         string `json:"author" scorum:"2"`
+	Permlink       string `json:"permlink" scorum:"3"`
+	Title          string `json:"title" scorum:"4"`
+	Body           string `json:"body" scorum:"5"`
+	JsonMetadata   string `json:"json_metadata" scorum:"6"`
 }
 
 func (op *CommentOperation) Type() OpType {
 	return CommentOpType
 }
 
+func (op *CommentOperation) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *CommentOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
+}
+
 type DeleteCommentOperation struct {
-	Author   string `json:"author"`
-	Permlink string `json:"permlink"`
+	Author   string `json:"author" scorum:"0"`
+	Permlink string `json:"permlink" scorum:"1"`
 }
 
 func (op *DeleteCommentOperation) Type() OpType {
 	return DeleteCommentOpType
 }
 
+func (op *DeleteCommentOperation) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *DeleteCommentOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
+}
+
 // CommentOptionsOperation operation allows authors to update properties associated with their post. Authors of posts
 // may not want all the benefits that come from creating a post.
 //
 // The max_accepted_payout may be decreased, but never increased.
 // The percent_scrs may be decreased, but never increased
 type CommentOptionsOperation struct {
-	Author               string        `json:"author"`
-	Permlink             string        `json:"permlink"`
-	MaxAcceptedPayout    string        `json:"max_accepted_payout"`
-	PercentSCRs          uint16        `json:"percent_scrs"`
-	AllowVotes           bool          `json:"allow_votes"`
-	AllowCurationRewards bool          `json:"allow_curation_rewards"`
-	Extensions           []interface{} `json:"extensions"`
+	Author               string                    `json:"author" scorum:"0"`
+	Permlink             string                    `json:"permlink" scorum:"1"`
+	MaxAcceptedPayout    Asset                     `json:"max_accepted_payout" scorum:"2"`
+	PercentSCRs          uint16                    `json:"percent_scrs" scorum:"3"`
+	AllowVotes           bool                      `json:"allow_votes" scorum:"4"`
+	AllowCurationRewards bool                      `json:"allow_curation_rewards" scorum:"5"`
+	Extensions           []CommentOptionsExtension `json:"extensions" scorum:"6,vector"`
 }
 
 func (op *CommentOptionsOperation) Type() OpType {
 	return CommentOptionsOpType
 }
 
+func (op *CommentOptionsOperation) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *CommentOptionsOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
+}
+
 type ProducerRewardOperation struct {
 	Producer    string `json:"producer"`
-	Scorumpower string `json:"reward"`
+	Scorumpower Asset  `json:"reward"`
 }
 
 func (op *ProducerRewardOperation) Type() OpType {
@@ -389,12 +520,12 @@ func (op *ProducerRewardOperation) Type() OpType {
 }
 
 type AccountUpdateOperation struct {
-	Account      string    `json:"account"`
-	Owner        Authority `json:"owner"`
-	Active       Authority `json:"active"`
-	Posting      Authority `json:"posting"`
-	MemoKey      PublicKey `json:"memo_key"`
-	JsonMetadata string    `json:"json_metadata"`
+	Account      string    `json:"account" scorum:"0"`
+	Owner        Authority `json:"owner" scorum:"1"`
+	Active       Authority `json:"active" scorum:"2"`
+	Posting      Authority `json:"posting" scorum:"3"`
+	MemoKey      PublicKey `json:"memo_key" scorum:"4"`
+	JsonMetadata string    `json:"json_metadata" scorum:"5"`
 }
 
 func (op *AccountUpdateOperation) Type() OpType {
@@ -402,30 +533,34 @@ func (op *AccountUpdateOperation) Type() OpType {
 }
 
 func (op *AccountUpdateOperation) MarshalTransaction(encoder *transaction.Encoder) error {
-	enc := transaction.NewRollingEncoder(encoder)
-	enc.EncodeUVarint(uint64(op.Type().Code()))
-	enc.Encode(op.Account)
-	enc.Encode(op.Owner)
-	enc.Encode(op.Active)
-	enc.Encode(op.Posting)
-	enc.Encode(op.MemoKey)
-	enc.Encode(op.JsonMetadata)
-	return enc.Err()
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *AccountUpdateOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
 }
 
 type WithdrawScorumpowerOperation struct {
-	Account     string `json:"account"`
-	Scorumpower string `json:"scorumpower"`
+	Account     string `json:"account" scorum:"0"`
+	Scorumpower Asset  `json:"scorumpower" scorum:"1"`
 }
 
 func (op *WithdrawScorumpowerOperation) Type() OpType {
 	return WithdrawScorumpowerOpType
 }
 
+func (op *WithdrawScorumpowerOperation) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *WithdrawScorumpowerOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
+}
+
 type DelegateScorumpowerOperation struct {
-	Delegator   string `json:"delegator"`
-	Delegatee   string `json:"delegatee"`
-	Scorumpower string `json:"scorumpower"`
+	Delegator   string `json:"delegator" scorum:"0"`
+	Delegatee   string `json:"delegatee" scorum:"1"`
+	Scorumpower Asset  `json:"scorumpower" scorum:"2"`
 }
 
 func (op *DelegateScorumpowerOperation) Type() OpType {
@@ -433,22 +568,21 @@ func (op *DelegateScorumpowerOperation) Type() OpType {
 }
 
 func (op *DelegateScorumpowerOperation) MarshalTransaction(encoder *transaction.Encoder) error {
-	enc := transaction.NewRollingEncoder(encoder)
-	enc.EncodeUVarint(uint64(op.Type().Code()))
-	enc.Encode(op.Delegator)
-	enc.Encode(op.Delegatee)
-	enc.EncodeMoney(op.Scorumpower)
-	return enc.Err()
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *DelegateScorumpowerOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
 }
 
 type CreateGameOperation struct {
-	UUID                uuid.UUID `json:"uuid"`
-	Moderator           string    `json:"moderator"`
-	JsonMetadata        string    `json:"json_metadata"`
-	GameType            GameType  `json:"game"`
-	StartTime           Time      `json:"start_time"`
-	AutoResolveDelaySec uint32    `json:"auto_resolve_delay_sec"`
-	Markets             []Market  `json:"markets"`
+	UUID                uuid.UUID `json:"uuid" scorum:"0,uuid"`
+	Moderator           string    `json:"moderator" scorum:"1"`
+	JsonMetadata        string    `json:"json_metadata" scorum:"2"`
+	GameType            GameType  `json:"game" scorum:"5,variant"`
+	StartTime           Time      `json:"start_time" scorum:"3"`
+	AutoResolveDelaySec uint32    `json:"auto_resolve_delay_sec" scorum:"4"`
+	Markets             []Market  `json:"markets" scorum:"6,vector"`
 }
 
 func (op *CreateGameOperation) Type() OpType {
@@ -456,24 +590,34 @@ func (op *CreateGameOperation) Type() OpType {
 }
 
 func (op *CreateGameOperation) MarshalTransaction(encoder *transaction.Encoder) error {
-	enc := transaction.NewRollingEncoder(encoder)
-	enc.EncodeUVarint(uint64(op.Type().Code()))
-	enc.EncodeUUID(op.UUID)
-	enc.Encode(op.Moderator)
-	enc.Encode(op.JsonMetadata)
-	op.StartTime.MarshalTransaction(encoder)
-	enc.Encode(op.AutoResolveDelaySec)
-	enc.Encode(uint8(op.GameType))
-	enc.EncodeUVarint(uint64((len(op.Markets))))
-	for _, m := range op.Markets {
-		enc.Encode(m)
-	}
-	return enc.Err()
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *CreateGameOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
+}
+
+type UpdateGameMarketsOperation struct {
+	UUID      uuid.UUID `json:"uuid" scorum:"0,uuid"`
+	Moderator string    `json:"moderator" scorum:"1"`
+	Markets   []Market  `json:"markets" scorum:"2,vector"`
+}
+
+func (op *UpdateGameMarketsOperation) Type() OpType {
+	return UpdateGameMarkets
+}
+
+func (op *UpdateGameMarketsOperation) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *UpdateGameMarketsOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
 }
 
 type CancelGameOperation struct {
-	UUID      uuid.UUID `json:"uuid"`
-	Moderator string    `json:"moderator"`
+	UUID      uuid.UUID `json:"uuid" scorum:"0,uuid"`
+	Moderator string    `json:"moderator" scorum:"1"`
 }
 
 func (op *CancelGameOperation) Type() OpType {
@@ -481,35 +625,34 @@ func (op *CancelGameOperation) Type() OpType {
 }
 
 func (op *CancelGameOperation) MarshalTransaction(encoder *transaction.Encoder) error {
-	enc := transaction.NewRollingEncoder(encoder)
-	enc.EncodeUVarint(uint64(op.Type().Code()))
-	enc.EncodeUUID(op.UUID)
-	enc.Encode(op.Moderator)
-	return enc.Err()
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *CancelGameOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
 }
 
 type UpdateGameStartTimeOperation struct {
-	UUID      uuid.UUID `json:"uuid"`
-	Moderator string    `json:"moderator"`
-	StartTime Time      `json:"start_time"`
+	UUID      uuid.UUID `json:"uuid" scorum:"0,uuid"`
+	Moderator string    `json:"moderator" scorum:"1"`
+	StartTime Time      `json:"start_time" scorum:"2"`
 }
 
 func (op *UpdateGameStartTimeOperation) Type() OpType {
 	return UpdateGameStartTime
 }
 func (op *UpdateGameStartTimeOperation) MarshalTransaction(encoder *transaction.Encoder) error {
-	enc := transaction.NewRollingEncoder(encoder)
-	enc.EncodeUVarint(uint64(op.Type().Code()))
-	enc.EncodeUUID(op.UUID)
-	enc.Encode(op.Moderator)
-	op.StartTime.MarshalTransaction(encoder)
-	return enc.Err()
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *UpdateGameStartTimeOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
 }
 
 type PostGameResultsOperation struct {
-	UUID      uuid.UUID `json:"uuid"`
-	Moderator string    `json:"moderator"`
-	Wincases  []Wincase `json:"wincases"`
+	UUID      uuid.UUID `json:"uuid" scorum:"0,uuid"`
+	Moderator string    `json:"moderator" scorum:"1"`
+	Wincases  []Wincase `json:"wincases" scorum:"2,vector"`
 }
 
 func (op *PostGameResultsOperation) Type() OpType {
@@ -517,37 +660,34 @@ func (op *PostGameResultsOperation) Type() OpType {
 }
 
 func (op *PostGameResultsOperation) MarshalTransaction(encoder *transaction.Encoder) error {
-	enc := transaction.NewRollingEncoder(encoder)
-	enc.EncodeUVarint(uint64(op.Type().Code()))
-	enc.EncodeUUID(op.UUID)
-	enc.Encode(op.Moderator)
-	enc.Encode(int8(len(op.Wincases)))
-	for _, m := range op.Wincases {
-		enc.Encode(m)
-	}
-	return enc.Err()
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *PostGameResultsOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
 }
 
 type Odds struct {
-	Numerator   int32 `json:"numerator"`
-	Denominator int32 `json:"denominator"`
+	Numerator   int32 `json:"numerator" scorum:"0"`
+	Denominator int32 `json:"denominator" scorum:"1"`
 }
 
 func (o Odds) MarshalTransaction(encoder *transaction.Encoder) error {
-	enc := transaction.NewRollingEncoder(encoder)
-	enc.EncodeNumber(o.Numerator)
-	enc.EncodeNumber(o.Denominator)
-	return enc.Err()
+	return encoder.EncodeStruct(o)
+}
+
+func (o *Odds) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decoder.DecodeStruct(o)
 }
 
 type PostBetOperation struct {
-	UUID     uuid.UUID `json:"uuid"`
-	Better   string    `json:"better"`
-	GameUUID uuid.UUID `json:"game_uuid"`
-	Wincase  Wincase   `json:"wincase"`
-	Odds     Odds      `json:"odds"`
-	Stake    Asset     `json:"stake"`
-	Live     bool      `json:"live"`
+	UUID     uuid.UUID `json:"uuid" scorum:"0,uuid"`
+	Better   string    `json:"better" scorum:"1"`
+	GameUUID uuid.UUID `json:"game_uuid" scorum:"2,uuid"`
+	Wincase  Wincase   `json:"wincase" scorum:"3,variant"`
+	Odds     Odds      `json:"odds" scorum:"4"`
+	Stake    Asset     `json:"stake" scorum:"5"`
+	Live     bool      `json:"live" scorum:"6"`
 }
 
 func (op *PostBetOperation) Type() OpType {
@@ -555,21 +695,16 @@ func (op *PostBetOperation) Type() OpType {
 }
 
 func (op *PostBetOperation) MarshalTransaction(encoder *transaction.Encoder) error {
-	enc := transaction.NewRollingEncoder(encoder)
-	enc.EncodeUVarint(uint64(op.Type().Code()))
-	enc.EncodeUUID(op.UUID)
-	enc.Encode(op.Better)
-	enc.EncodeUUID(op.GameUUID)
-	enc.Encode(op.Wincase)
-	enc.Encode(op.Odds)
-	enc.EncodeMoney(op.Stake.String())
-	enc.EncodeBool(op.Live)
-	return enc.Err()
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *PostBetOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
 }
 
 type CancelPendingBetsOperation struct {
-	BetIDs []uuid.UUID `json:"bet_uuids"`
-	Better string      `json:"better"`
+	BetIDs []uuid.UUID `json:"bet_uuids" scorum:"0,vector"`
+	Better string      `json:"better" scorum:"1"`
 }
 
 func (op *CancelPendingBetsOperation) Type() OpType {
@@ -577,14 +712,11 @@ func (op *CancelPendingBetsOperation) Type() OpType {
 }
 
 func (op *CancelPendingBetsOperation) MarshalTransaction(encoder *transaction.Encoder) error {
-	enc := transaction.NewRollingEncoder(encoder)
-	enc.EncodeUVarint(uint64(op.Type().Code()))
-	enc.EncodeUVarint(uint64(len(op.BetIDs)))
-	for _, v := range op.BetIDs {
-		enc.EncodeUUID(v)
-	}
-	enc.Encode(op.Better)
-	return enc.Err()
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *CancelPendingBetsOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
 }
 
 type BetsMatchedVirtualOperation struct {
@@ -660,9 +792,9 @@ func (op *BetCancelledOperation) Type() OpType {
 }
 
 type DelegateSPFromRegPoolOperation struct {
-	RegCommitteeMember string `json:"reg_committee_member"`
-	Delegatee          string `json:"delegatee"`
-	Scorumpower        string `json:"scorumpower"`
+	RegCommitteeMember string `json:"reg_committee_member" scorum:"0"`
+	Delegatee          string `json:"delegatee" scorum:"1"`
+	Scorumpower        Asset  `json:"scorumpower" scorum:"2"`
 }
 
 func (op *DelegateSPFromRegPoolOperation) Type() OpType {
@@ -670,20 +802,19 @@ func (op *DelegateSPFromRegPoolOperation) Type() OpType {
 }
 
 func (op *DelegateSPFromRegPoolOperation) MarshalTransaction(encoder *transaction.Encoder) error {
-	enc := transaction.NewRollingEncoder(encoder)
-	enc.EncodeUVarint(uint64(op.Type().Code()))
-	enc.Encode(op.RegCommitteeMember)
-	enc.Encode(op.Delegatee)
-	enc.EncodeMoney(op.Scorumpower)
-	return enc.Err()
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *DelegateSPFromRegPoolOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
 }
 
 type CreateNFTOperation struct {
-	OwnerAccount string    `json:"owner"`
-	UUID         uuid.UUID `json:"uuid"`
-	Name         string    `json:"name"`
-	JSONMetadata string    `json:"json_metadata"`
-	InitialPower int32     `json:"initial_power"`
+	OwnerAccount string    `json:"owner" scorum:"0"`
+	UUID         uuid.UUID `json:"uuid" scorum:"1,uuid"`
+	Name         string    `json:"name" scorum:"2"`
+	JSONMetadata string    `json:"json_metadata" scorum:"3"`
+	InitialPower int32     `json:"initial_power" scorum:"4"`
 }
 
 func (op *CreateNFTOperation) Type() OpType {
@@ -691,20 +822,17 @@ func (op *CreateNFTOperation) Type() OpType {
 }
 
 func (op *CreateNFTOperation) MarshalTransaction(encoder *transaction.Encoder) error {
-	enc := transaction.NewRollingEncoder(encoder)
-	enc.EncodeUVarint(uint64(op.Type().Code()))
-	enc.Encode(op.OwnerAccount)
-	enc.EncodeUUID(op.UUID)
-	enc.Encode(op.Name)
-	enc.Encode(op.JSONMetadata)
-	enc.Encode(op.InitialPower)
-	return enc.Err()
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *CreateNFTOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
 }
 
 type UpdateNFTMetadataOperation struct {
-	Moderator    string    `json:"moderator"`
-	UUID         uuid.UUID `json:"uuid"`
-	JSONMetadata string    `json:"json_metadata"`
+	Moderator    string    `json:"moderator" scorum:"0"`
+	UUID         uuid.UUID `json:"uuid" scorum:"1,uuid"`
+	JSONMetadata string    `json:"json_metadata" scorum:"2"`
 }
 
 func (op *UpdateNFTMetadataOperation) Type() OpType {
@@ -712,18 +840,17 @@ func (op *UpdateNFTMetadataOperation) Type() OpType {
 }
 
 func (op *UpdateNFTMetadataOperation) MarshalTransaction(encoder *transaction.Encoder) error {
-	enc := transaction.NewRollingEncoder(encoder)
-	enc.EncodeUVarint(uint64(op.Type().Code()))
-	enc.Encode(op.Moderator)
-	enc.EncodeUUID(op.UUID)
-	enc.Encode(op.JSONMetadata)
-	return enc.Err()
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *UpdateNFTMetadataOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
 }
 
 type AdjustNFTExperienceOperation struct {
-	Moderator  string    `json:"moderator"`
-	UUID       uuid.UUID `json:"uuid"`
-	Experience int32     `json:"experience"`
+	Moderator  string    `json:"moderator" scorum:"0"`
+	UUID       uuid.UUID `json:"uuid" scorum:"1,uuid"`
+	Experience int32     `json:"experience" scorum:"2"`
 }
 
 func (op *AdjustNFTExperienceOperation) Type() OpType {
@@ -731,18 +858,17 @@ func (op *AdjustNFTExperienceOperation) Type() OpType {
 }
 
 func (op *AdjustNFTExperienceOperation) MarshalTransaction(encoder *transaction.Encoder) error {
-	enc := transaction.NewRollingEncoder(encoder)
-	enc.EncodeUVarint(uint64(op.Type().Code()))
-	enc.Encode(op.Moderator)
-	enc.EncodeUUID(op.UUID)
-	enc.Encode(op.Experience)
-	return enc.Err()
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *AdjustNFTExperienceOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
 }
 
 type UpdateNFTNameOperation struct {
-	Moderator string    `json:"moderator"`
-	UUID      uuid.UUID `json:"uuid"`
-	Name      string    `json:"name"`
+	Moderator string    `json:"moderator" scorum:"0"`
+	UUID      uuid.UUID `json:"uuid" scorum:"1,uuid"`
+	Name      string    `json:"name" scorum:"2"`
 }
 
 func (op *UpdateNFTNameOperation) Type() OpType {
@@ -750,68 +876,399 @@ func (op *UpdateNFTNameOperation) Type() OpType {
 }
 
 func (op *UpdateNFTNameOperation) MarshalTransaction(encoder *transaction.Encoder) error {
-	enc := transaction.NewRollingEncoder(encoder)
-	enc.EncodeUVarint(uint64(op.Type().Code()))
-	enc.Encode(op.Moderator)
-	enc.EncodeUUID(op.UUID)
-	enc.Encode(op.Name)
-	return enc.Err()
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *UpdateNFTNameOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
 }
 
 type CreateGameRoundOperation struct {
-	Owner           string    `json:"owner"`
-	UUID            uuid.UUID `json:"uuid"`
-	VerificationKey string    `json:"verification_key"`
-	Seed            string    `json:"seed"`
+	Owner           string    `json:"owner" scorum:"0"`
+	UUID            uuid.UUID `json:"uuid" scorum:"1,uuid"`
+	VerificationKey string    `json:"verification_key" scorum:"2"`
+	Seed            string    `json:"seed" scorum:"3"`
 }
 
 func (op *CreateGameRoundOperation) Type() OpType { return CreateGameRound }
 
 func (op *CreateGameRoundOperation) MarshalTransaction(encoder *transaction.Encoder) error {
-	enc := transaction.NewRollingEncoder(encoder)
-	enc.EncodeUVarint(uint64(op.Type().Code()))
-	enc.Encode(op.Owner)
-	enc.EncodeUUID(op.UUID)
-	enc.Encode(op.VerificationKey)
-	enc.Encode(op.Seed)
-	return enc.Err()
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *CreateGameRoundOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
 }
 
 type UpdateGameRoundResultOperation struct {
-	Owner  string    `json:"owner"`
-	UUID   uuid.UUID `json:"uuid"`
-	Proof  string    `json:"proof"`
-	Vrf    string    `json:"vrf"`
-	Result int32     `json:"result"`
+	Owner  string    `json:"owner" scorum:"0"`
+	UUID   uuid.UUID `json:"uuid" scorum:"1,uuid"`
+	Proof  string    `json:"proof" scorum:"2"`
+	Vrf    string    `json:"vrf" scorum:"3"`
+	Result int32     `json:"result" scorum:"4"`
 }
 
 func (op *UpdateGameRoundResultOperation) Type() OpType { return UpdateGameRoundResult }
 
 func (op *UpdateGameRoundResultOperation) MarshalTransaction(encoder *transaction.Encoder) error {
-	enc := transaction.NewRollingEncoder(encoder)
-	enc.EncodeUVarint(uint64(op.Type().Code()))
-	enc.Encode(op.Owner)
-	enc.EncodeUUID(op.UUID)
-	enc.Encode(op.Proof)
-	enc.Encode(op.Vrf)
-	enc.Encode(op.Result)
-	return enc.Err()
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *UpdateGameRoundResultOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
 }
 
 type BurnOperation struct {
-	Owner string `json:"owner"`
-	To    string `json:"to"`
+	Owner string `json:"owner" scorum:"0"`
+	To    string `json:"to" scorum:"1"`
 
-	Amount string `json:"amount"`
+	Amount Asset `json:"amount" scorum:"2"`
 }
 
 func (op *BurnOperation) Type() OpType { return BurnOperationOpType }
 
 func (op *BurnOperation) MarshalTransaction(encoder *transaction.Encoder) error {
-	enc := transaction.NewRollingEncoder(encoder)
-	enc.EncodeUVarint(uint64(op.Type().Code()))
-	enc.Encode(op.Owner)
-	enc.Encode(op.To)
-	enc.EncodeMoney(op.Amount)
-	return enc.Err()
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *BurnOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
+}
+
+type AtomicswapInitiateKind string
+
+const (
+	AtomicswapByInitiator   AtomicswapInitiateKind = "by_initiator"
+	AtomicswapByParticipant AtomicswapInitiateKind = "by_participant"
+)
+
+var atomicswapInitiateKinds = []AtomicswapInitiateKind{
+	AtomicswapByInitiator,
+	AtomicswapByParticipant,
+}
+
+// MarshalTransaction encodes the kind as its index.
+func (kind AtomicswapInitiateKind) MarshalTransaction(encoder *transaction.Encoder) error {
+	for i, v := range atomicswapInitiateKinds {
+		if v == kind {
+			return encoder.Encode(int64(i))
+		}
+	}
+	return fmt.Errorf("unknown atomicswap initiate kind: %q", kind)
+}
+
+func (kind *AtomicswapInitiateKind) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	var i int64
+	if err := decoder.Decode(&i); err != nil {
+		return err
+	}
+	if i < 0 || i >= int64(len(atomicswapInitiateKinds)) {
+		return fmt.Errorf("unknown atomicswap initiate kind: %d", i)
+	}
+	*kind = atomicswapInitiateKinds[i]
+	return nil
+}
+
+// InitiateAtomicswapOperation locks the amount on the owner balance until the recipient
+// redeems it with the secret which hashes to SecretHash or the owner refunds it after the lock period.
+type InitiateAtomicswapOperation struct {
+	Kind       AtomicswapInitiateKind `json:"type" scorum:"0"`
+	Owner      string                 `json:"owner" scorum:"1"`
+	Recipient  string                 `json:"recipient" scorum:"2"`
+	Amount     Asset                  `json:"amount" scorum:"3"`
+	SecretHash string                 `json:"secret_hash" scorum:"4"`
+	Metadata   string                 `json:"metadata" scorum:"5"`
+}
+
+func (op *InitiateAtomicswapOperation) Type() OpType { return AtomicswapInitiate }
+
+func (op *InitiateAtomicswapOperation) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *InitiateAtomicswapOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
+}
+
+type RedeemAtomicswapOperation struct {
+	From   string `json:"from" scorum:"0"`
+	To     string `json:"to" scorum:"1"`
+	Secret string `json:"secret" scorum:"2"`
+}
+
+func (op *RedeemAtomicswapOperation) Type() OpType { return AtomicswapRedeem }
+
+func (op *RedeemAtomicswapOperation) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *RedeemAtomicswapOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
+}
+
+type RefundAtomicswapOperation struct {
+	Participant string `json:"participant" scorum:"0"`
+	Initiator   string `json:"initiator" scorum:"1"`
+	SecretHash  string `json:"secret_hash" scorum:"2"`
+}
+
+func (op *RefundAtomicswapOperation) Type() OpType { return AtomicswapRefund }
+
+func (op *RefundAtomicswapOperation) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *RefundAtomicswapOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
+}
+
+// EscrowTransferOperation transfers the amount into the escrow, the agent and the receiver
+// have to approve it before RatificationDeadline, otherwise it is returned back to the sender.
+type EscrowTransferOperation struct {
+	From                 string `json:"from" scorum:"0"`
+	To                   string `json:"to" scorum:"1"`
+	ScorumAmount         Asset  `json:"scorum_amount" scorum:"2"`
+	EscrowID             uint32 `json:"escrow_id" scorum:"3"`
+	Agent                string `json:"agent" scorum:"4"`
+	Fee                  Asset  `json:"fee" scorum:"5"`
+	JsonMeta             string `json:"json_meta" scorum:"6"`
+	RatificationDeadline Time   `json:"ratification_deadline" scorum:"7"`
+	EscrowExpiration     Time   `json:"escrow_expiration" scorum:"8"`
+}
+
+func (op *EscrowTransferOperation) Type() OpType { return EscrowTransfer }
+
+func (op *EscrowTransferOperation) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *EscrowTransferOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
+}
+
+// EscrowApproveOperation is sent by the agent and the receiver to approve (or reject) the escrow transfer.
+type EscrowApproveOperation struct {
+	From     string `json:"from" scorum:"0"`
+	To       string `json:"to" scorum:"1"`
+	Agent    string `json:"agent" scorum:"2"`
+	Who      string `json:"who" scorum:"3"`
+	EscrowID uint32 `json:"escrow_id" scorum:"4"`
+	Approve  bool   `json:"approve" scorum:"5"`
+}
+
+func (op *EscrowApproveOperation) Type() OpType { return EscrowApprove }
+
+func (op *EscrowApproveOperation) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *EscrowApproveOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
+}
+
+// EscrowDisputeOperation raises a dispute, after that only the agent is allowed to release the funds.
+type EscrowDisputeOperation struct {
+	From     string `json:"from" scorum:"0"`
+	To       string `json:"to" scorum:"1"`
+	Agent    string `json:"agent" scorum:"2"`
+	Who      string `json:"who" scorum:"3"`
+	EscrowID uint32 `json:"escrow_id" scorum:"4"`
+}
+
+func (op *EscrowDisputeOperation) Type() OpType { return EscrowDispute }
+
+func (op *EscrowDisputeOperation) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *EscrowDisputeOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
+}
+
+// EscrowReleaseOperation releases the amount from the escrow to the receiver.
+type EscrowReleaseOperation struct {
+	From         string `json:"from" scorum:"0"`
+	To           string `json:"to" scorum:"1"`
+	Agent        string `json:"agent" scorum:"2"`
+	Who          string `json:"who" scorum:"3"`
+	Receiver     string `json:"receiver" scorum:"4"`
+	EscrowID     uint32 `json:"escrow_id" scorum:"5"`
+	ScorumAmount Asset  `json:"scorum_amount" scorum:"6"`
+}
+
+func (op *EscrowReleaseOperation) Type() OpType { return EscrowRelease }
+
+func (op *EscrowReleaseOperation) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *EscrowReleaseOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
+}
+
+// ProveAuthorityOperation is used to respond to the authority challenge.
+type ProveAuthorityOperation struct {
+	Challenged   string `json:"challenged" scorum:"0"`
+	RequireOwner bool   `json:"require_owner" scorum:"1"`
+}
+
+func (op *ProveAuthorityOperation) Type() OpType { return ProveAuthority }
+
+func (op *ProveAuthorityOperation) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *ProveAuthorityOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
+}
+
+// RequestAccountRecoveryOperation is sent by the recovery account of the account to recover,
+// it starts the recovery which has to be completed with RecoverAccountOperation before the request expires.
+type RequestAccountRecoveryOperation struct {
+	RecoveryAccount   string           `json:"recovery_account" scorum:"0"`
+	AccountToRecover  string           `json:"account_to_recover" scorum:"1"`
+	NewOwnerAuthority Authority        `json:"new_owner_authority" scorum:"2"`
+	Extensions        FutureExtensions `json:"extensions" scorum:"3"`
+}
+
+func (op *RequestAccountRecoveryOperation) Type() OpType { return RequestAccountRecovery }
+
+func (op *RequestAccountRecoveryOperation) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *RequestAccountRecoveryOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
+}
+
+// RecoverAccountOperation has to be signed by both the new owner authority
+// and the recent owner authority, the one which was valid during the recovery period.
+type RecoverAccountOperation struct {
+	AccountToRecover     string           `json:"account_to_recover" scorum:"0"`
+	NewOwnerAuthority    Authority        `json:"new_owner_authority" scorum:"1"`
+	RecentOwnerAuthority Authority        `json:"recent_owner_authority" scorum:"2"`
+	Extensions           FutureExtensions `json:"extensions" scorum:"3"`
+}
+
+func (op *RecoverAccountOperation) Type() OpType { return RecoverAccount }
+
+func (op *RecoverAccountOperation) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *RecoverAccountOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
+}
+
+type ChangeRecoveryAccountOperation struct {
+	AccountToRecover   string           `json:"account_to_recover" scorum:"0"`
+	NewRecoveryAccount string           `json:"new_recovery_account" scorum:"1"`
+	Extensions         FutureExtensions `json:"extensions" scorum:"2"`
+}
+
+func (op *ChangeRecoveryAccountOperation) Type() OpType { return ChangeRecoveryAccount }
+
+func (op *ChangeRecoveryAccountOperation) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *ChangeRecoveryAccountOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
+}
+
+// CreateProposalOperation creates a proposal to the committee the creator is a member of.
+// The operation is applied as soon as the proposal is voted by the committee quorum within LifetimeSec.
+type CreateProposalOperation struct {
+	Creator     string            `json:"creator" scorum:"0"`
+	LifetimeSec uint32            `json:"lifetime_sec" scorum:"1"`
+	Operation   ProposalOperation `json:"operation" scorum:"2,variant"`
+}
+
+func (op *CreateProposalOperation) Type() OpType { return ProposalCreate }
+
+func (op *CreateProposalOperation) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *CreateProposalOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
+}
+
+type VoteProposalOperation struct {
+	VotingAccount string `json:"voting_account" scorum:"0"`
+	ProposalID    int64  `json:"proposal_id" scorum:"1"`
+}
+
+func (op *VoteProposalOperation) Type() OpType { return ProposalVote }
+
+func (op *VoteProposalOperation) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *VoteProposalOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
+}
+
+// ProposalVirtualOperation is generated when the proposal operation is applied.
+type ProposalVirtualOperation struct {
+	ProposalOp ProposalOperation `json:"proposal_op"`
+}
+
+func (op *ProposalVirtualOperation) Type() OpType { return ProposalVirtual }
+
+// SetWithdrawScorumpowerRouteToAccountOperation routes the percent of the scorumpower withdrawal to the account,
+// the routed part is converted back to scorumpower in case AutoVest is set.
+type SetWithdrawScorumpowerRouteToAccountOperation struct {
+	FromAccount string `json:"from_account" scorum:"0"`
+	ToAccount   string `json:"to_account" scorum:"1"`
+	Percent     uint16 `json:"percent" scorum:"2"`
+	AutoVest    bool   `json:"auto_vest" scorum:"3"`
+}
+
+func (op *SetWithdrawScorumpowerRouteToAccountOperation) Type() OpType {
+	return SetWithdrawScorumpowerRouteToAccount
+}
+
+func (op *SetWithdrawScorumpowerRouteToAccountOperation) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *SetWithdrawScorumpowerRouteToAccountOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
+}
+
+// SetWithdrawScorumpowerRouteToDevPoolOperation routes the percent of the scorumpower withdrawal to the development pool.
+type SetWithdrawScorumpowerRouteToDevPoolOperation struct {
+	FromAccount string `json:"from_account" scorum:"0"`
+	Percent     uint16 `json:"percent" scorum:"1"`
+	AutoVest    bool   `json:"auto_vest" scorum:"2"`
+}
+
+func (op *SetWithdrawScorumpowerRouteToDevPoolOperation) Type() OpType {
+	return SetWithdrawScorumpowerRouteToDevPool
+}
+
+func (op *SetWithdrawScorumpowerRouteToDevPoolOperation) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *SetWithdrawScorumpowerRouteToDevPoolOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
+}
+
+// DeclineVotingRightsOperation irreversibly declines the account voting rights once the decline period is over.
+// Sending it with Decline set to false cancels the pending request.
+type DeclineVotingRightsOperation struct {
+	Account string `json:"account" scorum:"0"`
+	Decline bool   `json:"decline" scorum:"1"`
+}
+
+func (op *DeclineVotingRightsOperation) Type() OpType { return DeclineVotingRights }
+
+func (op *DeclineVotingRightsOperation) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encodeTaggedOperation(encoder, op)
+}
+
+func (op *DeclineVotingRightsOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decodeTaggedOperation(decoder, op)
 }
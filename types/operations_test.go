@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 
@@ -23,7 +24,7 @@ func TestCreateGameOperation_SerializationWithoutMarkets(t *testing.T) {
 		UUID:                uuid,
 		Moderator:           "admin",
 		JsonMetadata:        "{}",
-		StartTime:           Time{&time},
+		StartTime:           NewTime(time),
 		GameType:            SoccerGameType,
 		AutoResolveDelaySec: 33,
 	}
@@ -46,7 +47,7 @@ func TestCreateGameOperation_SerializationWithTotalMarket(t *testing.T) {
 		UUID:                uuid,
 		Moderator:           "admin",
 		JsonMetadata:        "{}",
-		StartTime:           Time{&time},
+		StartTime:           NewTime(time),
 		AutoResolveDelaySec: 33,
 		Markets: []Market{Market{&OverUnderMarket{
 			ID:        MarketTotal,
@@ -70,7 +71,7 @@ func TestCreateGameOperation_SerializationManyMarkets(t *testing.T) {
 		UUID:                uuid,
 		Moderator:           "moderator_name",
 		JsonMetadata:        "{}",
-		StartTime:           Time{&time},
+		StartTime:           NewTime(time),
 		AutoResolveDelaySec: 33,
 		Markets: []Market{
 			Market{&YesNoMarket{
@@ -490,3 +491,285 @@ func TestAccountCreateOperation(t *testing.T) {
 		hex.EncodeToString(b.Bytes()),
 	)
 }
+
+func TestInitiateAtomicswapOperation_MarshalTransaction(t *testing.T) {
+	amount, err := AssetFromString("1.000000000 SCR")
+	require.NoError(t, err)
+
+	op := InitiateAtomicswapOperation{
+		Kind:       AtomicswapByParticipant,
+		Owner:      "alice",
+		Recipient:  "bob",
+		Amount:     *amount,
+		SecretHash: "ab",
+		Metadata:   "",
+	}
+
+	var b bytes.Buffer
+	encoder := transaction.NewEncoder(&b)
+
+	require.NoError(t, op.MarshalTransaction(encoder))
+	require.Equal(t,
+		"1e010000000000000005616c69636503626f6200ca9a3b00000000095343520000000002616200",
+		hex.EncodeToString(b.Bytes()),
+	)
+
+	op.Kind = "unknown"
+	require.Error(t, op.MarshalTransaction(encoder))
+}
+
+func TestEscrowTransferOperation_UnmarshalJSON(t *testing.T) {
+	data := `[["escrow_transfer", {
+		"from": "alice",
+		"to": "bob",
+		"scorum_amount": "1.000000000 SCR",
+		"escrow_id": 7,
+		"agent": "sam",
+		"fee": "0.100000000 SCR",
+		"json_meta": "",
+		"ratification_deadline": "2018-05-01T00:00:00",
+		"escrow_expiration": "2018-06-01T00:00:00"
+	}]]`
+
+	var ops OperationsArray
+	require.NoError(t, json.Unmarshal([]byte(data), &ops))
+	require.Len(t, ops, 1)
+
+	op, ok := ops[0].(*EscrowTransferOperation)
+	require.True(t, ok)
+	require.Equal(t, "sam", op.Agent)
+	require.EqualValues(t, 7, op.EscrowID)
+	require.Equal(t, "0.100000000 SCR", op.Fee.String())
+
+	var b bytes.Buffer
+	encoder := transaction.NewEncoder(&b)
+
+	require.NoError(t, op.MarshalTransaction(encoder))
+	require.Equal(t,
+		"1705616c69636503626f6200ca9a3b000000000953435200000000070000000373616d00e1f5050000000009534352000000000000aee75a808c105b",
+		hex.EncodeToString(b.Bytes()),
+	)
+}
+
+func TestRecoverAccountOperation_MarshalTransaction(t *testing.T) {
+	op := RecoverAccountOperation{
+		AccountToRecover: "bob",
+		NewOwnerAuthority: Authority{
+			WeightThreshold: 1,
+			AccountAuths:    NewAccountAuthorityMap(),
+			KeyAuths:        NewKeyAuthorityMap(KeyAuthority{Key: "SCR7zPNg5nAsJjP9gvMfQ4UnAwDwf91WPYC8KFzobtMuQ52ns1D6T", Weight: 1}),
+		},
+		RecentOwnerAuthority: Authority{
+			WeightThreshold: 1,
+			AccountAuths:    NewAccountAuthorityMap(),
+			KeyAuths:        NewKeyAuthorityMap(KeyAuthority{Key: "SCR5jPZF7PMgTpLqkdfpMu8kXea8Gio6E646aYpTgcjr9qMLrAgnL", Weight: 1}),
+		},
+	}
+
+	var b bytes.Buffer
+	encoder := transaction.NewEncoder(&b)
+
+	require.NoError(t, op.MarshalTransaction(encoder))
+	require.Equal(t,
+		"1203626f6201000000000103987a5a967458c114c15091198c06a822f54b494ea486204551a53f85effa31420100010000000001026f0896f24d94252c351715bfe6052bbf9ea820e805bd47c2496c626d3467da5d010000",
+		hex.EncodeToString(b.Bytes()),
+	)
+}
+
+func TestSetWithdrawScorumpowerRouteToAccountOperation_MarshalTransaction(t *testing.T) {
+	op := SetWithdrawScorumpowerRouteToAccountOperation{
+		FromAccount: "alice",
+		ToAccount:   "bob",
+		Percent:     5000,
+		AutoVest:    true,
+	}
+
+	var b bytes.Buffer
+	encoder := transaction.NewEncoder(&b)
+
+	require.NoError(t, op.MarshalTransaction(encoder))
+	require.Equal(t, "0e05616c69636503626f62881301", hex.EncodeToString(b.Bytes()))
+}
+
+func TestWithdrawScorumpowerOperation_MarshalTransaction(t *testing.T) {
+	op := WithdrawScorumpowerOperation{
+		Account:     "alice",
+		Scorumpower: *NewAsset(1000000000, 9, SP),
+	}
+
+	var b bytes.Buffer
+	encoder := transaction.NewEncoder(&b)
+
+	require.NoError(t, op.MarshalTransaction(encoder))
+	require.Equal(t, "0405616c69636500ca9a3b000000000953500000000000", hex.EncodeToString(b.Bytes()))
+}
+
+func TestDeleteCommentOperation_MarshalTransaction(t *testing.T) {
+	op := DeleteCommentOperation{
+		Author:   "alice",
+		Permlink: "post",
+	}
+
+	var b bytes.Buffer
+	encoder := transaction.NewEncoder(&b)
+
+	require.NoError(t, op.MarshalTransaction(encoder))
+	require.Equal(t, "0c05616c69636504706f7374", hex.EncodeToString(b.Bytes()))
+}
+
+func TestCommentOptionsOperation_JSON(t *testing.T) {
+	data := `{
+		"author": "alice",
+		"permlink": "post",
+		"max_accepted_payout": "1000000.000000000 SCR",
+		"percent_scrs": 10000,
+		"allow_votes": true,
+		"allow_curation_rewards": true,
+		"extensions": [[0, {"beneficiaries": [{"account": "bob", "weight": 1000}, {"account": "al", "weight": 500}]}]]
+	}`
+
+	var op CommentOptionsOperation
+	require.NoError(t, json.Unmarshal([]byte(data), &op))
+	require.Len(t, op.Extensions, 1)
+	require.Equal(t, []BeneficiaryRoute{{Account: "bob", Weight: 1000}, {Account: "al", Weight: 500}}, op.Extensions[0].Beneficiaries.Beneficiaries)
+
+	// the beneficiaries are sorted by account in both JSON and binary forms, so the node rebuilds the signed transaction
+	b, err := json.Marshal(&op)
+	require.NoError(t, err)
+	require.JSONEq(t, strings.Replace(data, `{"account": "bob", "weight": 1000}, {"account": "al", "weight": 500}`,
+		`{"account": "al", "weight": 500}, {"account": "bob", "weight": 1000}`, 1), string(b))
+
+	var buf bytes.Buffer
+	require.NoError(t, op.MarshalTransaction(transaction.NewEncoder(&buf)))
+	require.Equal(t, "0d05616c69636504706f73740080c6a47e8d030009534352000000001027010101000202616cf40103626f62e803", hex.EncodeToString(buf.Bytes()))
+
+	var sorted CommentOptionsOperation
+	require.NoError(t, json.Unmarshal(b, &sorted))
+	var resorted bytes.Buffer
+	require.NoError(t, sorted.MarshalTransaction(transaction.NewEncoder(&resorted)))
+	require.Equal(t, buf.Bytes(), resorted.Bytes())
+}
+
+func TestCommentPayoutBeneficiaries_Routes(t *testing.T) {
+	b := CommentPayoutBeneficiaries{Beneficiaries: []BeneficiaryRoute{
+		{Account: "carol", Weight: 100},
+		{Account: "alice", Weight: 300},
+		{Account: "bob", Weight: 200},
+	}}
+
+	data, err := json.Marshal(b)
+	require.NoError(t, err)
+	require.Equal(t, `{"beneficiaries":[{"account":"alice","weight":300},{"account":"bob","weight":200},{"account":"carol","weight":100}]}`, string(data))
+	require.Equal(t, "carol", b.Beneficiaries[0].Account, "the caller's slice must be left as it is")
+
+	data, err = json.Marshal(CommentPayoutBeneficiaries{})
+	require.NoError(t, err)
+	require.Equal(t, `{"beneficiaries":null}`, string(data))
+}
+
+func TestCommentOperation_MarshalTransaction(t *testing.T) {
+	op := CommentOperation{
+		ParentPermlink: "tag",
+		Author:         "alice",
+		Permlink:       "post",
+		Title:          "t",
+		Body:           "b",
+		JsonMetadata:   "{}",
+	}
+
+	var b bytes.Buffer
+	encoder := transaction.NewEncoder(&b)
+
+	require.NoError(t, op.MarshalTransaction(encoder))
+	require.Equal(t, "01000374616705616c69636504706f737401740162027b7d", hex.EncodeToString(b.Bytes()))
+}
+
+func TestCreateGameOperation_SerializationHockey(t *testing.T) {
+	time := time.Unix(1461605400, 0)
+
+	uuid := uuid.UUID{}
+	require.NoError(t, uuid.UnmarshalText([]byte("e629f9aa-6b2c-46aa-8fa8-36770e7a7a5f")))
+
+	op := CreateGameOperation{
+		UUID:                uuid,
+		Moderator:           "admin",
+		JsonMetadata:        "{}",
+		StartTime:           NewTime(time),
+		AutoResolveDelaySec: 33,
+		GameType:            HockeyGameType,
+		Markets: []Market{
+			{&YesNoMarket{ID: MarketResultHome}},
+		},
+	}
+
+	var b bytes.Buffer
+	require.NoError(t, op.MarshalTransaction(transaction.NewEncoder(&b)))
+	require.EqualValues(t, "23e629f9aa6b2c46aa8fa836770e7a7a5f0561646d696e027b7d18541e5721000000010100", hex.EncodeToString(b.Bytes()))
+
+	op.GameType = 5
+	require.Error(t, op.MarshalTransaction(transaction.NewEncoder(&b)))
+}
+
+func TestUpdateGameMarketsOperation_Serialization(t *testing.T) {
+	uuid := uuid.UUID{}
+	require.NoError(t, uuid.UnmarshalText([]byte("e629f9aa-6b2c-46aa-8fa8-36770e7a7a5f")))
+
+	op := UpdateGameMarketsOperation{
+		UUID:      uuid,
+		Moderator: "admin",
+		Markets: []Market{
+			{&YesNoMarket{ID: MarketResultDraw}},
+			{&OverUnderMarket{ID: MarketTotal, Threshold: 1000}},
+		},
+	}
+
+	var b bytes.Buffer
+	require.NoError(t, op.MarshalTransaction(transaction.NewEncoder(&b)))
+	require.EqualValues(t, "25e629f9aa6b2c46aa8fa836770e7a7a5f0561646d696e02010ce803", hex.EncodeToString(b.Bytes()))
+}
+
+func TestUpdateGameMarketsOperation_JSON(t *testing.T) {
+	data := `["update_game_markets",{
+		"uuid":"e629f9aa-6b2c-46aa-8fa8-36770e7a7a5f",
+		"moderator":"admin",
+		"markets":[["result_draw",{}],["total",{"threshold":1000}]]
+	}]`
+
+	var ops OperationsArray
+	require.NoError(t, json.Unmarshal([]byte("["+data+"]"), &ops))
+	require.Len(t, ops, 1)
+
+	op, ok := ops[0].(*UpdateGameMarketsOperation)
+	require.True(t, ok)
+	require.Equal(t, "admin", op.Moderator)
+	require.Len(t, op.Markets, 2)
+
+	b, err := json.Marshal(ops)
+	require.NoError(t, err)
+	require.JSONEq(t, "["+data+"]", string(b))
+}
+
+func TestWitnessUpdateOperation_MarshalTransaction(t *testing.T) {
+	var op WitnessUpdateOperation
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"owner": "alice",
+		"url": "u",
+		"block_signing_key": "SCR7cTf2Dx9rxffs6E2z2pdn5cLMneo3AAFSsF9g4SaVviCYdfQ63",
+		"props": {"account_creation_fee": "0.000000750 SCR", "maximum_block_size": 65536}
+	}`), &op))
+
+	var b bytes.Buffer
+	require.NoError(t, op.MarshalTransaction(transaction.NewEncoder(&b)))
+	require.Equal(t, "0905616c69636501750366b11f2f616e44c59bcf082a3e00e77e6b9c0057161a62af3fc16176eb6ba104ee02000000000000095343520000000000000100", hex.EncodeToString(b.Bytes()))
+}
+
+func TestAccountWitnessProxyOperation_MarshalTransaction(t *testing.T) {
+	op := AccountWitnessProxyOperation{
+		Account: "alice",
+		Proxy:   "bob",
+	}
+
+	var b bytes.Buffer
+	require.NoError(t, op.MarshalTransaction(transaction.NewEncoder(&b)))
+	require.Equal(t, "0b05616c69636503626f62", hex.EncodeToString(b.Bytes()))
+}
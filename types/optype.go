@@ -2,107 +2,11 @@ package types
 
 type OpType string
 
-// Code returns the operation code associated with the given operation type.
+// Code returns the operation code associated with the given operation type by the active hardfork code table.
+// It returns 0, the code of vote, for the operation unknown to the table, use ActiveOpCodes().Code to tell them apart.
 func (kind OpType) Code() uint16 {
-	return opCodes[kind]
-}
-
-// opCodes keeps mapping operation type -> operation code.
-var opCodes map[OpType]uint16
-
-func init() {
-	opCodes = make(map[OpType]uint16, len(opTypes))
-	for i, opType := range opTypes {
-		opCodes[opType] = uint16(i)
-	}
-}
-
-var opTypes = []OpType{
-	VoteOpType,
-	CommentOpType,
-	TransferOpType,
-	TransferToScorumpowerOpType,
-	WithdrawScorumpowerOpType,
-	AccountCreateByCommitteeOpType,
-	AccountCreateOpType,
-	AccountCreateWithDelegationOpType,
-	AccountUpdateOpType,
-	WitnessUpdateOpType,
-	AccountWitnessVoteOpType,
-	AccountWitnessProxyOpType,
-	DeleteCommentOpType,
-	CommentOptionsOpType,
-	SetWithdrawScorumpowerRouteToAccount,
-	SetWithdrawScorumpowerRouteToDevPool,
-	ProveAuthority,
-	RequestAccountRecovery,
-	RecoverAccount,
-	ChangeRecoveryAccount,
-	EscrowApprove,
-	EscrowDispute,
-	EscrowRelease,
-	EscrowTransfer,
-	DeclineVotingRights,
-	DelegateScorumpower,
-	CreateBudget,
-	CloseBudget,
-	ProposalVoteOperation,
-	ProposalCreateOperation,
-	AtomicswapInitiateOperation,
-	AtomicswapRedeemOperation,
-	AtomicswapRefundOperation,
-
-	CloseBudgetByAdvertisingModeratorOperation,
-	UpdateBudgetOperation,
-
-	CreateGame,
-	CancelGame,
-	UpdateGameMarkets,
-	UpdateGameStartTime,
-	PostGameResults,
-	PostBet,
-	CancelPendingBets,
-
-	DelegateSPFromRegPool,
-
-	CreateNFT,
-	UpdateNFTMetadata,
-	CreateGameRound,
-	UpdateGameRoundResult,
-	AdjustNFTExperience,
-	UpdateNFTName,
-
-	BurnOperationOpType,
-
-	// virtual operations
-	CommentBenefactorReward,
-	CommentPayoutUpdate,
-	CommentReward,
-	CurationReward,
-	FillScorumpowerWithdraw,
-	Hardfork,
-	ProducerRewardOpType,
-	ReturnScorumpowerDelegation,
-	ShutdownWitness,
-	WitnessMissBlock,
-	ExpiredContractRefund,
-	AccFinishedVestingWithdraw,
-	DevpoolFinishedVestingWithdraw,
-	AccToAccVestingWithdraw,
-	DevpoolToAccVestingWithdraw,
-	AccToDevpoolVestingWithdraw,
-	DevpoolToDevpoolVesting,
-	ProposalVirtual,
-	ActiveSpHoldersRewardLegacy,
-	AllocateCashFromAdvertisingBudget,
-	CashBackFromAdvertisingBudgetToOwner,
-	ClosingBudget,
-	BetsMatched,
-	GameStatusChanged,
-	BetResolved,
-	BetCancelled,
-	BetRestored,
-	BetUpdated,
+	code, _ := ActiveOpCodes().Code(kind)
+	return code
 }
 
 const (
@@ -134,11 +38,11 @@ const (
 	DelegateScorumpower                  OpType = "delegate_scorumpower"
 	CreateBudget                         OpType = "create_budget"
 	CloseBudget                          OpType = "close_budget"
-	ProposalVoteOperation                OpType = "proposal_vote_operation"
-	ProposalCreateOperation              OpType = "proposal_create_operation"
-	AtomicswapInitiateOperation          OpType = "atomicswap_initiate_operation"
-	AtomicswapRedeemOperation            OpType = "atomicswap_redeem_operation"
-	AtomicswapRefundOperation            OpType = "atomicswap_refund_operation"
+	ProposalVote                         OpType = "proposal_vote_operation"
+	ProposalCreate                       OpType = "proposal_create_operation"
+	AtomicswapInitiate                   OpType = "atomicswap_initiate_operation"
+	AtomicswapRedeem                     OpType = "atomicswap_redeem_operation"
+	AtomicswapRefund                     OpType = "atomicswap_refund_operation"
 	BurnOperationOpType                  OpType = "burn"
 
 	CloseBudgetByAdvertisingModeratorOperation OpType = "close_budget_by_advertising_moderator"
@@ -194,3 +98,17 @@ const (
 	BetRestored  OpType = "bet_restored"
 	BetUpdated   OpType = "bet_updated"
 )
+
+// The names the operation types had before the operation structs took them.
+const (
+	// Deprecated: use ProposalVote.
+	ProposalVoteOperation = ProposalVote
+	// Deprecated: use ProposalCreate.
+	ProposalCreateOperation = ProposalCreate
+	// Deprecated: use AtomicswapInitiate.
+	AtomicswapInitiateOperation = AtomicswapInitiate
+	// Deprecated: use AtomicswapRedeem.
+	AtomicswapRedeemOperation = AtomicswapRedeem
+	// Deprecated: use AtomicswapRefund.
+	AtomicswapRefundOperation = AtomicswapRefund
+)
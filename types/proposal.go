@@ -0,0 +1,407 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/scorum/scorum-go/encoding/transaction"
+)
+
+type ProposalOperationID uint8
+
+const (
+	RegistrationCommitteeAddMember ProposalOperationID = iota
+	RegistrationCommitteeExcludeMember
+	RegistrationCommitteeChangeQuorum
+	DevelopmentCommitteeAddMember
+	DevelopmentCommitteeExcludeMember
+	DevelopmentCommitteeChangeQuorum
+	DevelopmentCommitteeWithdrawVesting
+	DevelopmentCommitteeTransfer
+	DevelopmentCommitteeChangePostBudgetsAuctionProperties
+	DevelopmentCommitteeChangeBannerBudgetsAuctionProperties
+	DevelopmentCommitteeEmpowerAdvertisingModerator
+	DevelopmentCommitteeChangeBettingResolveDelay
+	DevelopmentCommitteeEmpowerBettingModerator
+)
+
+var (
+	errUnknownProposalOperation = errors.New("unknown proposal operation")
+	errEmptyProposalOperation   = errors.New("empty proposal operation")
+)
+
+var ProposalOperationNames = map[ProposalOperationID]string{
+	RegistrationCommitteeAddMember:                           "registration_committee_add_member",
+	RegistrationCommitteeExcludeMember:                       "registration_committee_exclude_member",
+	RegistrationCommitteeChangeQuorum:                        "registration_committee_change_quorum",
+	DevelopmentCommitteeAddMember:                            "development_committee_add_member",
+	DevelopmentCommitteeExcludeMember:                        "development_committee_exclude_member",
+	DevelopmentCommitteeChangeQuorum:                         "development_committee_change_quorum",
+	DevelopmentCommitteeWithdrawVesting:                      "development_committee_withdraw_vesting",
+	DevelopmentCommitteeTransfer:                             "development_committee_transfer",
+	DevelopmentCommitteeChangePostBudgetsAuctionProperties:   "development_committee_change_post_budgets_auction_properties",
+	DevelopmentCommitteeChangeBannerBudgetsAuctionProperties: "development_committee_change_banner_budgets_auction_properties",
+	DevelopmentCommitteeEmpowerAdvertisingModerator:          "development_committee_empower_advertising_moderator",
+	DevelopmentCommitteeChangeBettingResolveDelay:            "development_committee_change_betting_resolve_delay",
+	DevelopmentCommitteeEmpowerBettingModerator:              "development_committee_empower_betting_moderator",
+}
+
+var proposalOperations = map[ProposalOperationID]reflect.Type{
+	RegistrationCommitteeAddMember:                           reflect.TypeOf(RegistrationCommitteeAddMemberProposal{}),
+	RegistrationCommitteeExcludeMember:                       reflect.TypeOf(RegistrationCommitteeExcludeMemberProposal{}),
+	RegistrationCommitteeChangeQuorum:                        reflect.TypeOf(RegistrationCommitteeChangeQuorumProposal{}),
+	DevelopmentCommitteeAddMember:                            reflect.TypeOf(DevelopmentCommitteeAddMemberProposal{}),
+	DevelopmentCommitteeExcludeMember:                        reflect.TypeOf(DevelopmentCommitteeExcludeMemberProposal{}),
+	DevelopmentCommitteeChangeQuorum:                         reflect.TypeOf(DevelopmentCommitteeChangeQuorumProposal{}),
+	DevelopmentCommitteeWithdrawVesting:                      reflect.TypeOf(DevelopmentCommitteeWithdrawVestingProposal{}),
+	DevelopmentCommitteeTransfer:                             reflect.TypeOf(DevelopmentCommitteeTransferProposal{}),
+	DevelopmentCommitteeChangePostBudgetsAuctionProperties:   reflect.TypeOf(DevelopmentCommitteeChangePostBudgetsAuctionPropertiesProposal{}),
+	DevelopmentCommitteeChangeBannerBudgetsAuctionProperties: reflect.TypeOf(DevelopmentCommitteeChangeBannerBudgetsAuctionPropertiesProposal{}),
+	DevelopmentCommitteeEmpowerAdvertisingModerator:          reflect.TypeOf(DevelopmentCommitteeEmpowerAdvertisingModeratorProposal{}),
+	DevelopmentCommitteeChangeBettingResolveDelay:            reflect.TypeOf(DevelopmentCommitteeChangeBettingResolveDelayProposal{}),
+	DevelopmentCommitteeEmpowerBettingModerator:              reflect.TypeOf(DevelopmentCommitteeEmpowerBettingModeratorProposal{}),
+}
+
+// QuorumType names the committee quorum changed by the change quorum proposals.
+type QuorumType string
+
+const (
+	NoneQuorum                     QuorumType = "none_quorum"
+	AddMemberQuorum                QuorumType = "add_member_quorum"
+	ExcludeMemberQuorum            QuorumType = "exclude_member_quorum"
+	BaseQuorum                     QuorumType = "base_quorum"
+	TransferQuorum                 QuorumType = "transfer_quorum"
+	AdvertisingModeratorQuorum     QuorumType = "advertising_moderator_quorum"
+	BudgetsAuctionPropertiesQuorum QuorumType = "budgets_auction_properties_quorum"
+	BettingModeratorQuorum         QuorumType = "betting_moderator_quorum"
+	BettingResolveDelayQuorum      QuorumType = "betting_resolve_delay_quorum"
+)
+
+var quorumTypes = []QuorumType{
+	NoneQuorum,
+	AddMemberQuorum,
+	ExcludeMemberQuorum,
+	BaseQuorum,
+	TransferQuorum,
+	AdvertisingModeratorQuorum,
+	BudgetsAuctionPropertiesQuorum,
+	BettingModeratorQuorum,
+	BettingResolveDelayQuorum,
+}
+
+func (q QuorumType) MarshalTransaction(encoder *transaction.Encoder) error {
+	for i, v := range quorumTypes {
+		if v == q {
+			return encoder.Encode(int64(i))
+		}
+	}
+	return fmt.Errorf("unknown quorum type: %q", q)
+}
+
+func (q *QuorumType) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	var i int64
+	if err := decoder.DecodeNumber(&i); err != nil {
+		return err
+	}
+
+	if i < 0 || i >= int64(len(quorumTypes)) {
+		return fmt.Errorf("unknown quorum type: %d", i)
+	}
+	*q = quorumTypes[i]
+	return nil
+}
+
+// ProposalOperation is the action which is applied as soon as the proposal gets the committee quorum.
+// It comes from the Api in the following form: ["name", {}]
+type ProposalOperation struct {
+	ProposalOperationInterface
+}
+
+type ProposalOperationInterface interface {
+	transaction.TransactionMarshaller
+
+	GetID() ProposalOperationID
+}
+
+func (p ProposalOperation) MarshalJSON() ([]byte, error) {
+	if p.ProposalOperationInterface == nil {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal([]interface{}{
+		ProposalOperationNames[p.GetID()],
+		p.ProposalOperationInterface,
+	})
+}
+
+func (p *ProposalOperation) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		p.ProposalOperationInterface = nil
+		return nil
+	}
+
+	name, value, err := unmarshalNamedTuple(b)
+	if err != nil {
+		return fmt.Errorf("invalid proposal operation: %w", err)
+	}
+
+	for id, v := range ProposalOperationNames {
+		if v != name {
+			continue
+		}
+
+		val := reflect.New(proposalOperations[id]).Interface()
+		if err := json.Unmarshal(value, val); err != nil {
+			return err
+		}
+		p.ProposalOperationInterface = val.(ProposalOperationInterface)
+		return nil
+	}
+
+	return errUnknownProposalOperation
+}
+
+func (p ProposalOperation) MarshalTransaction(encoder *transaction.Encoder) error {
+	if p.ProposalOperationInterface == nil {
+		return errEmptyProposalOperation
+	}
+
+	enc := transaction.NewRollingEncoder(encoder)
+	enc.EncodeUVarint(uint64(p.GetID()))
+	enc.Encode(p.ProposalOperationInterface)
+	return enc.Err()
+}
+
+func (p *ProposalOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	id, err := decoder.DecodeUVarint()
+	if err != nil {
+		return err
+	}
+
+	t, ok := proposalOperations[ProposalOperationID(id)]
+	if !ok || uint64(ProposalOperationID(id)) != id {
+		return fmt.Errorf("%w: %d", errUnknownProposalOperation, id)
+	}
+
+	val := reflect.New(t).Interface()
+	if err := decoder.Decode(val); err != nil {
+		return err
+	}
+	p.ProposalOperationInterface = val.(ProposalOperationInterface)
+	return nil
+}
+
+type RegistrationCommitteeAddMemberProposal struct {
+	AccountName string `json:"account_name" scorum:"0"`
+}
+
+func (p *RegistrationCommitteeAddMemberProposal) GetID() ProposalOperationID {
+	return RegistrationCommitteeAddMember
+}
+
+func (p *RegistrationCommitteeAddMemberProposal) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encoder.EncodeStruct(p)
+}
+
+func (p *RegistrationCommitteeAddMemberProposal) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decoder.DecodeStruct(p)
+}
+
+type RegistrationCommitteeExcludeMemberProposal struct {
+	AccountName string `json:"account_name" scorum:"0"`
+}
+
+func (p *RegistrationCommitteeExcludeMemberProposal) GetID() ProposalOperationID {
+	return RegistrationCommitteeExcludeMember
+}
+
+func (p *RegistrationCommitteeExcludeMemberProposal) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encoder.EncodeStruct(p)
+}
+
+func (p *RegistrationCommitteeExcludeMemberProposal) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decoder.DecodeStruct(p)
+}
+
+type RegistrationCommitteeChangeQuorumProposal struct {
+	Quorum          uint16     `json:"quorum" scorum:"0"`
+	CommitteeQuorum QuorumType `json:"committee_quorum" scorum:"1"`
+}
+
+func (p *RegistrationCommitteeChangeQuorumProposal) GetID() ProposalOperationID {
+	return RegistrationCommitteeChangeQuorum
+}
+
+func (p *RegistrationCommitteeChangeQuorumProposal) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encoder.EncodeStruct(p)
+}
+
+func (p *RegistrationCommitteeChangeQuorumProposal) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decoder.DecodeStruct(p)
+}
+
+type DevelopmentCommitteeAddMemberProposal struct {
+	AccountName string `json:"account_name" scorum:"0"`
+}
+
+func (p *DevelopmentCommitteeAddMemberProposal) GetID() ProposalOperationID {
+	return DevelopmentCommitteeAddMember
+}
+
+func (p *DevelopmentCommitteeAddMemberProposal) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encoder.EncodeStruct(p)
+}
+
+func (p *DevelopmentCommitteeAddMemberProposal) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decoder.DecodeStruct(p)
+}
+
+type DevelopmentCommitteeExcludeMemberProposal struct {
+	AccountName string `json:"account_name" scorum:"0"`
+}
+
+func (p *DevelopmentCommitteeExcludeMemberProposal) GetID() ProposalOperationID {
+	return DevelopmentCommitteeExcludeMember
+}
+
+func (p *DevelopmentCommitteeExcludeMemberProposal) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encoder.EncodeStruct(p)
+}
+
+func (p *DevelopmentCommitteeExcludeMemberProposal) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decoder.DecodeStruct(p)
+}
+
+type DevelopmentCommitteeChangeQuorumProposal struct {
+	Quorum          uint16     `json:"quorum" scorum:"0"`
+	CommitteeQuorum QuorumType `json:"committee_quorum" scorum:"1"`
+}
+
+func (p *DevelopmentCommitteeChangeQuorumProposal) GetID() ProposalOperationID {
+	return DevelopmentCommitteeChangeQuorum
+}
+
+func (p *DevelopmentCommitteeChangeQuorumProposal) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encoder.EncodeStruct(p)
+}
+
+func (p *DevelopmentCommitteeChangeQuorumProposal) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decoder.DecodeStruct(p)
+}
+
+// DevelopmentCommitteeWithdrawVestingProposal withdraws scorumpower of the development pool.
+type DevelopmentCommitteeWithdrawVestingProposal struct {
+	VestingShares Asset `json:"vesting_shares" scorum:"0"`
+}
+
+func (p *DevelopmentCommitteeWithdrawVestingProposal) GetID() ProposalOperationID {
+	return DevelopmentCommitteeWithdrawVesting
+}
+
+func (p *DevelopmentCommitteeWithdrawVestingProposal) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encoder.EncodeStruct(p)
+}
+
+func (p *DevelopmentCommitteeWithdrawVestingProposal) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decoder.DecodeStruct(p)
+}
+
+// DevelopmentCommitteeTransferProposal transfers SCR from the development pool to the account.
+type DevelopmentCommitteeTransferProposal struct {
+	Amount    Asset  `json:"amount" scorum:"0"`
+	ToAccount string `json:"to_account" scorum:"1"`
+}
+
+func (p *DevelopmentCommitteeTransferProposal) GetID() ProposalOperationID {
+	return DevelopmentCommitteeTransfer
+}
+
+func (p *DevelopmentCommitteeTransferProposal) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encoder.EncodeStruct(p)
+}
+
+func (p *DevelopmentCommitteeTransferProposal) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decoder.DecodeStruct(p)
+}
+
+type DevelopmentCommitteeChangePostBudgetsAuctionPropertiesProposal struct {
+	AuctionCoefficients []uint16 `json:"auction_coefficients" scorum:"0"`
+}
+
+func (p *DevelopmentCommitteeChangePostBudgetsAuctionPropertiesProposal) GetID() ProposalOperationID {
+	return DevelopmentCommitteeChangePostBudgetsAuctionProperties
+}
+
+func (p *DevelopmentCommitteeChangePostBudgetsAuctionPropertiesProposal) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encoder.EncodeStruct(p)
+}
+
+func (p *DevelopmentCommitteeChangePostBudgetsAuctionPropertiesProposal) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decoder.DecodeStruct(p)
+}
+
+type DevelopmentCommitteeChangeBannerBudgetsAuctionPropertiesProposal struct {
+	AuctionCoefficients []uint16 `json:"auction_coefficients" scorum:"0"`
+}
+
+func (p *DevelopmentCommitteeChangeBannerBudgetsAuctionPropertiesProposal) GetID() ProposalOperationID {
+	return DevelopmentCommitteeChangeBannerBudgetsAuctionProperties
+}
+
+func (p *DevelopmentCommitteeChangeBannerBudgetsAuctionPropertiesProposal) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encoder.EncodeStruct(p)
+}
+
+func (p *DevelopmentCommitteeChangeBannerBudgetsAuctionPropertiesProposal) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decoder.DecodeStruct(p)
+}
+
+type DevelopmentCommitteeEmpowerAdvertisingModeratorProposal struct {
+	Account string `json:"account" scorum:"0"`
+}
+
+func (p *DevelopmentCommitteeEmpowerAdvertisingModeratorProposal) GetID() ProposalOperationID {
+	return DevelopmentCommitteeEmpowerAdvertisingModerator
+}
+
+func (p *DevelopmentCommitteeEmpowerAdvertisingModeratorProposal) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encoder.EncodeStruct(p)
+}
+
+func (p *DevelopmentCommitteeEmpowerAdvertisingModeratorProposal) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decoder.DecodeStruct(p)
+}
+
+type DevelopmentCommitteeChangeBettingResolveDelayProposal struct {
+	DelaySec uint32 `json:"delay_sec" scorum:"0"`
+}
+
+func (p *DevelopmentCommitteeChangeBettingResolveDelayProposal) GetID() ProposalOperationID {
+	return DevelopmentCommitteeChangeBettingResolveDelay
+}
+
+func (p *DevelopmentCommitteeChangeBettingResolveDelayProposal) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encoder.EncodeStruct(p)
+}
+
+func (p *DevelopmentCommitteeChangeBettingResolveDelayProposal) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decoder.DecodeStruct(p)
+}
+
+type DevelopmentCommitteeEmpowerBettingModeratorProposal struct {
+	Account string `json:"account" scorum:"0"`
+}
+
+func (p *DevelopmentCommitteeEmpowerBettingModeratorProposal) GetID() ProposalOperationID {
+	return DevelopmentCommitteeEmpowerBettingModerator
+}
+
+func (p *DevelopmentCommitteeEmpowerBettingModeratorProposal) MarshalTransaction(encoder *transaction.Encoder) error {
+	return encoder.EncodeStruct(p)
+}
+
+func (p *DevelopmentCommitteeEmpowerBettingModeratorProposal) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decoder.DecodeStruct(p)
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/scorum/scorum-go/encoding/transaction"
+)
+
+func TestProposalOperation_UnmarshalJSON(t *testing.T) {
+	var p ProposalOperation
+	require.NoError(t, json.Unmarshal([]byte(`["development_committee_transfer",{"amount":"1.000000000 SCR","to_account":"alice"}]`), &p))
+	require.IsType(t, &DevelopmentCommitteeTransferProposal{}, p.ProposalOperationInterface)
+
+	transfer := p.ProposalOperationInterface.(*DevelopmentCommitteeTransferProposal)
+	require.Equal(t, "alice", transfer.ToAccount)
+	require.Equal(t, "1.000000000 SCR", transfer.Amount.String())
+
+	require.Equal(t, errUnknownProposalOperation, json.Unmarshal([]byte(`["unknown",{}]`), &p))
+}
+
+func TestProposalOperation_MarshalJSON(t *testing.T) {
+	p := ProposalOperation{&RegistrationCommitteeChangeQuorumProposal{
+		Quorum:          60,
+		CommitteeQuorum: AddMemberQuorum,
+	}}
+
+	b, err := json.Marshal(p)
+	require.NoError(t, err)
+	require.Equal(t, `["registration_committee_change_quorum",{"quorum":60,"committee_quorum":"add_member_quorum"}]`, string(b))
+}
+
+func TestCreateProposalOperation_MarshalTransaction(t *testing.T) {
+	op := CreateProposalOperation{
+		Creator:     "alice",
+		LifetimeSec: 86400,
+		Operation: ProposalOperation{&RegistrationCommitteeAddMemberProposal{
+			AccountName: "bob",
+		}},
+	}
+
+	var b bytes.Buffer
+	encoder := transaction.NewEncoder(&b)
+
+	require.NoError(t, op.MarshalTransaction(encoder))
+	require.Equal(t, "1d05616c696365805101000003626f62", hex.EncodeToString(b.Bytes()))
+
+	op.Operation = ProposalOperation{}
+	require.Error(t, op.MarshalTransaction(transaction.NewEncoder(&b)))
+}
+
+func TestProposalVirtualOperation_UnmarshalJSON(t *testing.T) {
+	data := `[["proposal_virtual",{"proposal_op":["registration_committee_exclude_member",{"account_name":"bob"}]}]]`
+
+	var ops OperationsArray
+	require.NoError(t, json.Unmarshal([]byte(data), &ops))
+	require.Len(t, ops, 1)
+
+	op, ok := ops[0].(*ProposalVirtualOperation)
+	require.True(t, ok)
+	require.Equal(t, "bob", op.ProposalOp.ProposalOperationInterface.(*RegistrationCommitteeExcludeMemberProposal).AccountName)
+}
+
+func TestProposalOperation_Null(t *testing.T) {
+	p := ProposalOperation{&DevelopmentCommitteeTransferProposal{ToAccount: "alice"}}
+	require.NoError(t, json.Unmarshal([]byte(`null`), &p))
+	require.Nil(t, p.ProposalOperationInterface)
+
+	b, err := json.Marshal(p)
+	require.NoError(t, err)
+	require.Equal(t, `null`, string(b))
+}
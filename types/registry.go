@@ -0,0 +1,86 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+var errOperationRegistered = errors.New("operation is already registered")
+
+// OperationFactory creates a new empty operation the JSON or the binary data is decoded into.
+// The binary encoding is available when the operation implements transaction.TransactionMarshaller
+// and transaction.TransactionUnmarshaller, the wire code is written by the operation itself using OpType.Code.
+type OperationFactory func() Operation
+
+var registry = struct {
+	sync.RWMutex
+	factories map[OpType]OperationFactory
+}{
+	factories: make(map[OpType]OperationFactory),
+}
+
+// RegisterOperation makes the library aware of the operation which is not supported yet,
+// e.g. a custom operation of a private chain or an operation added by a new hardfork.
+// The code is added to every known hardfork code table and must not be used by another operation.
+// It is expected to be called on start before any operation is encoded or decoded, e.g. from init.
+func RegisterOperation(opType OpType, code uint16, factory OperationFactory) error {
+	if factory == nil {
+		return fmt.Errorf("register %s: factory is nil", opType)
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+
+	if _, ok := knownOperations[opType]; ok {
+		return fmt.Errorf("register %s: %w", opType, errOperationRegistered)
+	}
+	if _, ok := registry.factories[opType]; ok {
+		return fmt.Errorf("register %s: %w", opType, errOperationRegistered)
+	}
+
+	tables := make([]*OpCodeTable, len(opCodeTables))
+	active := ActiveOpCodes()
+	for i, table := range opCodeTables {
+		t, err := table.withCode(opType, code)
+		if err != nil {
+			return fmt.Errorf("register %s: %w", opType, err)
+		}
+		tables[i] = t
+
+		if table == active {
+			active = t
+		}
+	}
+
+	if active == ActiveOpCodes() {
+		// the active table was selected with UseOpCodes and it is not the known one
+		t, err := active.withCode(opType, code)
+		if err != nil {
+			return fmt.Errorf("register %s: %w", opType, err)
+		}
+		active = t
+	}
+
+	registry.factories[opType] = factory
+	opCodeTables = tables
+	UseOpCodes(active)
+
+	return nil
+}
+
+// newOperation creates an empty operation of the built-in or registered operation type.
+func newOperation(opType OpType) (Operation, bool) {
+	if t, ok := knownOperations[opType]; ok {
+		return reflect.New(t).Interface().(Operation), true
+	}
+
+	registry.RLock()
+	factory, ok := registry.factories[opType]
+	registry.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	return factory(), true
+}
@@ -0,0 +1,159 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/scorum/scorum-go/encoding/transaction"
+)
+
+const customOpType OpType = "custom_op"
+
+type customOperation struct {
+	Account string `json:"account"`
+	Value   uint32 `json:"value"`
+}
+
+func (op *customOperation) Type() OpType { return customOpType }
+
+func (op *customOperation) MarshalTransaction(encoder *transaction.Encoder) error {
+	enc := transaction.NewRollingEncoder(encoder)
+	enc.EncodeUVarint(uint64(op.Type().Code()))
+	enc.Encode(op.Account)
+	enc.Encode(op.Value)
+	return enc.Err()
+}
+
+func (op *customOperation) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	dec := transaction.NewRollingDecoder(decoder)
+	decodeOpCode(dec, op.Type())
+	dec.Decode(&op.Account)
+	dec.Decode(&op.Value)
+	return dec.Err()
+}
+
+// restoreRegistry reverts the operations registered by the test.
+func restoreRegistry(t *testing.T) {
+	tables, active := opCodeTables, ActiveOpCodes()
+	t.Cleanup(func() {
+		registry.Lock()
+		defer registry.Unlock()
+
+		registry.factories = make(map[OpType]OperationFactory)
+		opCodeTables = tables
+		UseOpCodes(active)
+	})
+}
+
+func TestRegisterOperation(t *testing.T) {
+	restoreRegistry(t)
+
+	require.NoError(t, RegisterOperation(customOpType, 1000, func() Operation { return &customOperation{} }))
+	require.EqualValues(t, 1000, customOpType.Code())
+
+	t.Run("operations array", func(t *testing.T) {
+		var ops OperationsArray
+		require.NoError(t, json.Unmarshal([]byte(`[["custom_op",{"account":"alice","value":7}]]`), &ops))
+		require.Equal(t, OperationsArray{&customOperation{Account: "alice", Value: 7}}, ops)
+	})
+
+	t.Run("operations flat", func(t *testing.T) {
+		var ops OperationsFlat
+		require.NoError(t, json.Unmarshal([]byte(`["custom_op",{"account":"alice","value":7}]`), &ops))
+		require.Equal(t, OperationsFlat{&customOperation{Account: "alice", Value: 7}}, ops)
+	})
+
+	t.Run("operation info", func(t *testing.T) {
+		var info OperationInfo
+		require.NoError(t, json.Unmarshal([]byte(`{
+			"trx_id": "abc",
+			"timestamp": "2018-08-03T10:12:43",
+			"op": ["custom_op",{"account":"alice","value":7}]
+		}`), &info))
+		require.Equal(t, &customOperation{Account: "alice", Value: 7}, info.Operation)
+	})
+
+	t.Run("binary", func(t *testing.T) {
+		expiration := time.Date(2018, 8, 3, 10, 12, 43, 0, time.UTC)
+		tx := Transaction{Expiration: NewTime(expiration)}
+		tx.PushOperation(&customOperation{Account: "alice", Value: 7})
+
+		var b bytes.Buffer
+		require.NoError(t, tx.MarshalTransaction(transaction.NewEncoder(&b)))
+
+		var got Transaction
+		require.NoError(t, got.UnmarshalTransaction(transaction.NewDecoder(&b)))
+		require.Equal(t, tx, got)
+	})
+
+	t.Run("already registered", func(t *testing.T) {
+		err := RegisterOperation(customOpType, 1000, func() Operation { return &customOperation{} })
+		require.ErrorIs(t, err, errOperationRegistered)
+
+		err = RegisterOperation(VoteOpType, 0, func() Operation { return &VoteOperation{} })
+		require.ErrorIs(t, err, errOperationRegistered)
+	})
+}
+
+func TestRegisterOperation_Codes(t *testing.T) {
+	restoreRegistry(t)
+
+	factory := func() Operation { return &UnknownOperation{} }
+
+	require.Error(t, RegisterOperation("other_op", VoteOpType.Code(), factory), "the code is used")
+	require.Error(t, RegisterOperation(CreateBudget, CreateBudget.Code()+1, factory), "the operation has another code")
+	require.NoError(t, RegisterOperation(CreateBudget, CreateBudget.Code(), factory))
+}
+
+func TestUnknownOperation(t *testing.T) {
+	const data = `[["future_op",{"b":[1,2],"a":"x"}],["vote",{"voter":"alice","author":"bob","permlink":"post","weight":100}]]`
+
+	var ops OperationsArray
+	require.NoError(t, json.Unmarshal([]byte(data), &ops))
+	require.Equal(t, &UnknownOperation{Kind: "future_op", Data: json.RawMessage(`{"b":[1,2],"a":"x"}`)}, ops[0])
+
+	b, err := json.Marshal(ops)
+	require.NoError(t, err)
+	require.Equal(t, data, string(b))
+
+	var buf bytes.Buffer
+	require.Error(t, transaction.NewEncoder(&buf).Encode(ops[0]))
+}
+
+func TestRegisterOperation_Concurrent(t *testing.T) {
+	restoreRegistry(t)
+
+	started, stop, done := make(chan struct{}), make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; ; i++ {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			if _, err := OpCodesForVersion("0.5.0"); err != nil {
+				t.Error(err)
+				return
+			}
+			if i == 0 {
+				close(started)
+			}
+		}
+	}()
+	<-started
+
+	require.NoError(t, RegisterOperation(customOpType, 1000, func() Operation { return &customOperation{} }))
+	close(stop)
+	<-done
+
+	table, err := OpCodesForVersion("0.5.0")
+	require.NoError(t, err)
+	code, ok := table.Code(customOpType)
+	require.True(t, ok)
+	require.EqualValues(t, 1000, code)
+}
@@ -0,0 +1,219 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/scorum/scorum-go/encoding/transaction"
+)
+
+// StaticVariant is a value of the chain static_variant: the index of the alternative and its value.
+// In JSON it is represented as [index, value], the name of the alternative is accepted instead of the index.
+// The value of an alternative unknown to the library is kept as json.RawMessage,
+// such a value is marshaled back to the same JSON, but it can't be encoded into the binary form.
+type StaticVariant struct {
+	Index uint64
+	Value interface{}
+}
+
+func (v StaticVariant) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{v.Index, v.Value})
+}
+
+func (v StaticVariant) MarshalTransaction(encoder *transaction.Encoder) error {
+	if raw, ok := v.Value.(json.RawMessage); ok {
+		return fmt.Errorf("static variant %d %s is unknown and can't be encoded", v.Index, raw)
+	}
+
+	enc := transaction.NewRollingEncoder(encoder)
+	enc.EncodeUVarint(v.Index)
+	enc.Encode(v.Value)
+	return enc.Err()
+}
+
+// VoidExtension is the void_t alternative carrying no data, future_extensions consist of it only.
+type VoidExtension struct{}
+
+func (VoidExtension) MarshalJSON() ([]byte, error) {
+	return []byte("{}"), nil
+}
+
+func (VoidExtension) MarshalTransaction(encoder *transaction.Encoder) error {
+	return nil
+}
+
+func (*VoidExtension) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return nil
+}
+
+// staticVariantType is an alternative of the static variant, the alternatives are listed
+// in the order the chain declares them, so the position is the alternative index.
+type staticVariantType struct {
+	name string
+	new  func() interface{}
+}
+
+type staticVariantTypes []staticVariantType
+
+func (ts staticVariantTypes) index(raw json.RawMessage) (uint64, bool, error) {
+	var index uint64
+	if err := json.Unmarshal(raw, &index); err == nil {
+		return index, index < uint64(len(ts)), nil
+	}
+
+	var name string
+	if err := json.Unmarshal(raw, &name); err != nil {
+		return 0, false, fmt.Errorf("invalid static variant type %s", raw)
+	}
+
+	for i, t := range ts {
+		if t.name == name {
+			return uint64(i), true, nil
+		}
+	}
+	return 0, false, fmt.Errorf("unknown static variant type %s", name)
+}
+
+func (ts staticVariantTypes) unmarshalJSON(b []byte) (StaticVariant, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return StaticVariant{}, err
+	}
+
+	if len(raw) != 2 {
+		return StaticVariant{}, errors.New("invalid static variant format: should be type, value")
+	}
+
+	index, known, err := ts.index(raw[0])
+	if err != nil {
+		return StaticVariant{}, err
+	}
+
+	if !known {
+		return StaticVariant{Index: index, Value: append(json.RawMessage{}, raw[1]...)}, nil
+	}
+
+	val := ts[index].new()
+	if err := json.Unmarshal(raw[1], val); err != nil {
+		return StaticVariant{}, fmt.Errorf("%s: %w", ts[index].name, err)
+	}
+	return StaticVariant{Index: index, Value: val}, nil
+}
+
+func (ts staticVariantTypes) unmarshalJSONList(b []byte) ([]StaticVariant, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	vs := make([]StaticVariant, len(raw))
+	for i := range raw {
+		v, err := ts.unmarshalJSON(raw[i])
+		if err != nil {
+			return nil, err
+		}
+		vs[i] = v
+	}
+	return vs, nil
+}
+
+func (ts staticVariantTypes) unmarshalTransaction(decoder *transaction.Decoder) (StaticVariant, error) {
+	index, err := decoder.DecodeUVarint()
+	if err != nil {
+		return StaticVariant{}, err
+	}
+
+	// the binary form has no length, so the unknown alternative can't be skipped
+	if index >= uint64(len(ts)) {
+		return StaticVariant{}, fmt.Errorf("unknown static variant type %d", index)
+	}
+
+	val := ts[index].new()
+	if err := decoder.Decode(val); err != nil {
+		return StaticVariant{}, fmt.Errorf("%s: %w", ts[index].name, err)
+	}
+	return StaticVariant{Index: index, Value: val}, nil
+}
+
+func (ts staticVariantTypes) unmarshalTransactionList(decoder *transaction.Decoder) ([]StaticVariant, error) {
+	n, err := decoder.DecodeLength()
+	if err != nil || n == 0 {
+		return nil, err
+	}
+
+	vs := make([]StaticVariant, n)
+	for i := range vs {
+		v, err := ts.unmarshalTransaction(decoder)
+		if err != nil {
+			return nil, err
+		}
+		vs[i] = v
+	}
+	return vs, nil
+}
+
+func marshalStaticVariantsJSON(vs []StaticVariant) ([]byte, error) {
+	if vs == nil {
+		// the chain expects an empty list rather than null
+		return []byte("[]"), nil
+	}
+	return json.Marshal(vs)
+}
+
+func marshalStaticVariantsTransaction(encoder *transaction.Encoder, vs []StaticVariant) error {
+	enc := transaction.NewRollingEncoder(encoder)
+	enc.EncodeUVarint(uint64(len(vs)))
+	for _, v := range vs {
+		enc.Encode(v)
+	}
+	return enc.Err()
+}
+
+var futureExtensionTypes = staticVariantTypes{
+	{name: "void_t", new: func() interface{} { return &VoidExtension{} }},
+}
+
+// FutureExtensions are the extensions of transactions and operations reserved for the future use,
+// the only alternative known is VoidExtension.
+type FutureExtensions []StaticVariant
+
+func (exts FutureExtensions) MarshalJSON() ([]byte, error) {
+	return marshalStaticVariantsJSON(exts)
+}
+
+func (exts *FutureExtensions) UnmarshalJSON(b []byte) (err error) {
+	*exts, err = futureExtensionTypes.unmarshalJSONList(b)
+	return err
+}
+
+func (exts FutureExtensions) MarshalTransaction(encoder *transaction.Encoder) error {
+	return marshalStaticVariantsTransaction(encoder, exts)
+}
+
+func (exts *FutureExtensions) UnmarshalTransaction(decoder *transaction.Decoder) (err error) {
+	*exts, err = futureExtensionTypes.unmarshalTransactionList(decoder)
+	return err
+}
+
+// unmarshalNamedTuple decodes the [name, value] pair the named variants like games and markets are given in.
+func unmarshalNamedTuple(b []byte) (string, json.RawMessage, error) {
+	var kv []json.RawMessage
+	if err := json.Unmarshal(b, &kv); err != nil {
+		return "", nil, err
+	}
+
+	if len(kv) != 2 {
+		return "", nil, errors.New("invalid format: should be name, value")
+	}
+
+	var name string
+	if err := json.Unmarshal(kv[0], &name); err != nil {
+		return "", nil, err
+	}
+	return name, kv[1], nil
+}
@@ -0,0 +1,143 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/scorum/scorum-go/encoding/transaction"
+)
+
+func TestFutureExtensions(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		var exts FutureExtensions
+		b, err := json.Marshal(exts)
+		require.NoError(t, err)
+		require.Equal(t, "[]", string(b))
+
+		require.NoError(t, json.Unmarshal([]byte(`[]`), &exts))
+		require.Nil(t, exts)
+	})
+
+	t.Run("void", func(t *testing.T) {
+		var exts FutureExtensions
+		require.NoError(t, json.Unmarshal([]byte(`[[0,{}]]`), &exts))
+		require.Equal(t, FutureExtensions{{Index: 0, Value: &VoidExtension{}}}, exts)
+
+		b, err := json.Marshal(exts)
+		require.NoError(t, err)
+		require.Equal(t, `[[0,{}]]`, string(b))
+
+		var buf bytes.Buffer
+		require.NoError(t, exts.MarshalTransaction(transaction.NewEncoder(&buf)))
+		require.Equal(t, "0100", hex.EncodeToString(buf.Bytes()))
+
+		var got FutureExtensions
+		require.NoError(t, got.UnmarshalTransaction(transaction.NewDecoder(&buf)))
+		require.Equal(t, exts, got)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		var exts FutureExtensions
+		require.NoError(t, json.Unmarshal([]byte(`[[3,{"b":1,"a":2}]]`), &exts))
+		require.Equal(t, FutureExtensions{{Index: 3, Value: json.RawMessage(`{"b":1,"a":2}`)}}, exts)
+
+		b, err := json.Marshal(exts)
+		require.NoError(t, err)
+		require.Equal(t, `[[3,{"b":1,"a":2}]]`, string(b))
+
+		var buf bytes.Buffer
+		require.Error(t, exts.MarshalTransaction(transaction.NewEncoder(&buf)))
+
+		require.Error(t, exts.UnmarshalTransaction(transaction.NewDecoder(bytes.NewReader([]byte{1, 3}))))
+	})
+
+	t.Run("by name", func(t *testing.T) {
+		var exts FutureExtensions
+		require.NoError(t, json.Unmarshal([]byte(`[["void_t",{}]]`), &exts))
+		require.Equal(t, FutureExtensions{{Index: 0, Value: &VoidExtension{}}}, exts)
+
+		require.Error(t, json.Unmarshal([]byte(`[["unknown_t",{}]]`), &exts))
+		require.Error(t, json.Unmarshal([]byte(`[[0]]`), &exts))
+	})
+}
+
+func TestTransaction_Extensions(t *testing.T) {
+	expiration := time.Date(2016, 8, 8, 12, 24, 17, 0, time.UTC)
+	tx := Transaction{
+		RefBlockNum:    36029,
+		RefBlockPrefix: 1164960351,
+		Expiration:     NewTime(expiration),
+		Extensions:     FutureExtensions{{Index: 0, Value: &VoidExtension{}}},
+	}
+	tx.PushOperation(&VoteOperation{
+		Voter:    "xeroc",
+		Author:   "xeroc",
+		Permlink: "piston",
+		Weight:   10000,
+	})
+
+	var b bytes.Buffer
+	require.NoError(t, tx.MarshalTransaction(transaction.NewEncoder(&b)))
+	require.Equal(t, "bd8c5fe26f45f179a8570100057865726f63057865726f6306706973746f6e10270100", hex.EncodeToString(b.Bytes()))
+
+	var got Transaction
+	require.NoError(t, got.UnmarshalTransaction(transaction.NewDecoder(&b)))
+	require.Equal(t, tx, got)
+
+	data, err := json.Marshal(&tx)
+	require.NoError(t, err)
+
+	var fromJSON Transaction
+	require.NoError(t, json.Unmarshal(data, &fromJSON))
+	require.Equal(t, tx.Extensions, fromJSON.Extensions)
+}
+
+func TestBlockHeaderExtensions(t *testing.T) {
+	const data = `[[1,"0.2.1"],[2,{"hf_version":"0.3.0","hf_time":"2018-10-01T12:00:00"}]]`
+
+	var exts BlockHeaderExtensions
+	require.NoError(t, json.Unmarshal([]byte(data), &exts))
+
+	version, ok := exts.Version()
+	require.True(t, ok)
+	require.Equal(t, NewVersion(0, 2, 1), version)
+	require.Equal(t, "0.2.1", version.String())
+
+	vote := exts.HardforkVersionVote()
+	require.NotNil(t, vote)
+	require.Equal(t, NewVersion(0, 3, 0), vote.HFVersion)
+	require.Equal(t, time.Date(2018, 10, 1, 12, 0, 0, 0, time.UTC), vote.HFTime.Time)
+
+	b, err := json.Marshal(exts)
+	require.NoError(t, err)
+	require.Equal(t, data, string(b))
+
+	var buf bytes.Buffer
+	require.NoError(t, exts.MarshalTransaction(transaction.NewEncoder(&buf)))
+	require.Equal(t, "0201010002000200000300400cb25b", hex.EncodeToString(buf.Bytes()))
+
+	var got BlockHeaderExtensions
+	require.NoError(t, got.UnmarshalTransaction(transaction.NewDecoder(&buf)))
+	require.Equal(t, exts, got)
+}
+
+func TestBlockHeader_Extensions(t *testing.T) {
+	var header BlockHeader
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"previous": "00000009300eb6ecf852348bc8f38254f4f616c3",
+		"timestamp": "2018-12-05T15:17:06",
+		"witness": "scorumwitness1",
+		"transaction_merkle_root": "0000000000000000000000000000000000000000",
+		"extensions": [[1, "0.1.0"]]
+	}`), &header))
+
+	version, ok := header.Extensions.Version()
+	require.True(t, ok)
+	require.Equal(t, NewVersion(0, 1, 0), version)
+	require.Nil(t, header.Extensions.HardforkVersionVote())
+}
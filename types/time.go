@@ -1,30 +1,143 @@
 package types
 
 import (
+	"encoding/json"
+	"fmt"
+	"math"
 	"time"
 
 	"github.com/scorum/scorum-go/encoding/transaction"
 )
 
+// Layout is the JSON format of the chain time, the chain time is always UTC.
 const Layout = `"2006-01-02T15:04:05"`
 
+// timeLayouts are accepted by ParseTime, the fractional seconds are accepted by both of them.
+var timeLayouts = []string{
+	"2006-01-02T15:04:05",
+	time.RFC3339,
+}
+
+var (
+	// Epoch is the minimum chain time, the chain uses it for the events which have never happened,
+	// e.g. the last recovery of the account which has never been recovered.
+	Epoch = Time{time.Unix(0, 0).UTC()}
+
+	// Never is the maximum chain time, the chain uses it for the events which are not scheduled,
+	// e.g. the next vesting withdrawal of the account without a power down.
+	Never = Time{time.Unix(math.MaxUint32, 0).UTC()}
+)
+
+// Time is the chain time, it has the precision of a second and is always UTC.
+// The zero value is the missing time: it is encoded as null to JSON and as Epoch to the transactions.
 type Time struct {
-	*time.Time
+	time.Time
+}
+
+// NewTime returns the chain time of t converted to UTC and truncated to seconds.
+func NewTime(t time.Time) Time {
+	if t.IsZero() {
+		return Time{}
+	}
+	return Time{t.UTC().Truncate(time.Second)}
+}
+
+// ParseTime parses the chain time like "2006-01-02T15:04:05", which is UTC,
+// or the RFC3339 time like "2006-01-02T15:04:05+03:00". An empty string is the missing time.
+func ParseTime(s string) (Time, error) {
+	if s == "" {
+		return Time{}, nil
+	}
+
+	var err error
+	for _, layout := range timeLayouts {
+		var parsed time.Time
+		if parsed, err = time.ParseInLocation(layout, s, time.UTC); err == nil {
+			return NewTime(parsed), nil
+		}
+	}
+	return Time{}, fmt.Errorf("can't parse time %q: %w", s, err)
+}
+
+// IsEpoch reports whether t is Epoch, i.e. the event has never happened.
+func (t Time) IsEpoch() bool {
+	return t.Time.Equal(Epoch.Time)
 }
 
-func (t *Time) MarshalJSON() ([]byte, error) {
-	return []byte(t.Time.Format(Layout)), nil
+// IsNever reports whether t is Never, i.e. the event is not scheduled.
+func (t Time) IsNever() bool {
+	return t.Time.Equal(Never.Time)
+}
+
+// IsSet reports whether t is the actual time: neither missing, nor Epoch, nor Never.
+func (t Time) IsSet() bool {
+	return !t.IsZero() && !t.IsEpoch() && !t.IsNever()
+}
+
+// Add returns the chain time t+d, e.g. the transaction expiration or the end of the cashout window.
+// The missing time stays missing.
+func (t Time) Add(d time.Duration) Time {
+	if t.IsZero() {
+		return t
+	}
+	return NewTime(t.Time.Add(d))
+}
+
+// Until returns the duration from t till u by the chain clock, e.g. the time left before the expiration.
+func (t Time) Until(u Time) time.Duration {
+	return u.Time.Sub(t.Time)
+}
+
+// Passed reports whether the moment t has passed by the chain time now, e.g. whether the transaction has expired.
+// Never is never passed.
+func (t Time) Passed(now Time) bool {
+	return !t.IsNever() && now.Time.After(t.Time)
+}
+
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(t.Time.UTC().Format(Layout)), nil
 }
 
 func (t *Time) UnmarshalJSON(data []byte) error {
-	parsed, err := time.ParseInLocation(Layout, string(data), time.UTC)
+	if string(data) == "null" {
+		*t = Time{}
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("can't parse time %s: %w", data, err)
+	}
+
+	parsed, err := ParseTime(s)
 	if err != nil {
 		return err
 	}
-	t.Time = &parsed
+	*t = parsed
 	return nil
 }
 
-func (t *Time) MarshalTransaction(encoder *transaction.Encoder) error {
-	return encoder.Encode(uint32(t.Time.UTC().Unix()))
+// MarshalTransaction encodes the time as the seconds since the epoch, the missing time is encoded as Epoch.
+func (t Time) MarshalTransaction(encoder *transaction.Encoder) error {
+	var unix int64
+	if !t.IsZero() {
+		unix = t.Unix()
+	}
+	if unix < 0 || unix > math.MaxUint32 {
+		return fmt.Errorf("time %s is out of the chain time range", t.Time)
+	}
+	return encoder.EncodeUint32(uint32(unix))
+}
+
+func (t *Time) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	var unix uint32
+	if err := decoder.DecodeNumber(&unix); err != nil {
+		return err
+	}
+
+	*t = Time{time.Unix(int64(unix), 0).UTC()}
+	return nil
 }
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/scorum/scorum-go/encoding/transaction"
+)
+
+func TestParseTime(t *testing.T) {
+	expected := time.Date(2018, 8, 3, 10, 12, 43, 0, time.UTC)
+
+	for _, s := range []string{
+		"2018-08-03T10:12:43",
+		"2018-08-03T10:12:43.500",
+		"2018-08-03T10:12:43Z",
+		"2018-08-03T13:12:43+03:00",
+		"2018-08-03T05:12:43.999999-05:00",
+	} {
+		parsed, err := ParseTime(s)
+		require.NoError(t, err, s)
+		require.Equal(t, expected, parsed.Time, s)
+		require.Equal(t, time.UTC, parsed.Location(), s)
+	}
+
+	missing, err := ParseTime("")
+	require.NoError(t, err)
+	require.True(t, missing.IsZero())
+
+	for _, s := range []string{"2018-08-03", "2018-08-03 10:12:43", "10:12:43", "yesterday"} {
+		_, err := ParseTime(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestTime_JSON(t *testing.T) {
+	type object struct {
+		Created  Time `json:"created"`
+		Recovery Time `json:"last_account_recovery"`
+		Withdraw Time `json:"next_vesting_withdrawal"`
+		Missing  Time `json:"missing"`
+	}
+
+	data := `{"created":"2018-08-03T10:12:43","last_account_recovery":"1970-01-01T00:00:00","next_vesting_withdrawal":"2106-02-07T06:28:15","missing":null}`
+
+	var obj object
+	require.NoError(t, json.Unmarshal([]byte(data), &obj))
+	require.True(t, obj.Created.IsSet())
+	require.True(t, obj.Recovery.IsEpoch())
+	require.False(t, obj.Recovery.IsSet())
+	require.True(t, obj.Withdraw.IsNever())
+	require.False(t, obj.Withdraw.IsSet())
+	require.True(t, obj.Missing.IsZero())
+	require.False(t, obj.Missing.IsEpoch())
+
+	encoded, err := json.Marshal(obj)
+	require.NoError(t, err)
+	require.Equal(t, data, string(encoded))
+
+	var zero Time
+	encoded, err = json.Marshal(zero)
+	require.NoError(t, err)
+	require.Equal(t, "null", string(encoded))
+
+	require.Error(t, json.Unmarshal([]byte(`1533291163`), &zero))
+}
+
+func TestTime_MarshalTransaction(t *testing.T) {
+	encode := func(tm Time) (string, error) {
+		var b bytes.Buffer
+		err := tm.MarshalTransaction(transaction.NewEncoder(&b))
+		return hex.EncodeToString(b.Bytes()), err
+	}
+
+	for _, test := range []struct {
+		time     Time
+		expected string
+	}{
+		{NewTime(time.Date(2018, 8, 3, 10, 12, 43, 0, time.UTC)), "9b2a645b"},
+		{Epoch, "00000000"},
+		{Time{}, "00000000"},
+		{Never, "ffffffff"},
+	} {
+		got, err := encode(test.time)
+		require.NoError(t, err)
+		require.Equal(t, test.expected, got)
+
+		b, _ := hex.DecodeString(test.expected)
+		var decoded Time
+		require.NoError(t, decoded.UnmarshalTransaction(transaction.NewDecoder(bytes.NewReader(b))))
+		if !test.time.IsZero() {
+			require.Equal(t, test.time, decoded)
+		}
+	}
+
+	_, err := encode(Never.Add(time.Second))
+	require.Error(t, err)
+	_, err = encode(Epoch.Add(-time.Second))
+	require.Error(t, err)
+}
+
+func TestTime_Arithmetic(t *testing.T) {
+	created := NewTime(time.Date(2018, 8, 3, 10, 12, 43, 999, time.FixedZone("MSK", 3*60*60)))
+	require.Equal(t, time.Date(2018, 8, 3, 7, 12, 43, 0, time.UTC), created.Time)
+
+	cashout := created.Add(7 * 24 * time.Hour)
+	require.Equal(t, time.Date(2018, 8, 10, 7, 12, 43, 0, time.UTC), cashout.Time)
+	require.Equal(t, 7*24*time.Hour, created.Until(cashout))
+	require.Equal(t, created, created.Add(300*time.Millisecond))
+
+	require.False(t, cashout.Passed(created))
+	require.False(t, cashout.Passed(cashout))
+	require.True(t, cashout.Passed(cashout.Add(time.Second)))
+	require.True(t, Epoch.Passed(created))
+	require.False(t, Never.Passed(Never))
+
+	require.True(t, Time{}.Add(time.Hour).IsZero())
+}
@@ -3,23 +3,30 @@ package types
 import (
 	"bytes"
 	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"fmt"
 
 	"github.com/scorum/scorum-go/encoding/transaction"
 )
 
+// signatureLength is the length of the compact signature, the chain encodes the signatures without a length prefix.
+const signatureLength = 65
+
 type Transaction struct {
-	RefBlockNum    uint16          `json:"ref_block_num"`
-	RefBlockPrefix uint32          `json:"ref_block_prefix"`
-	Expiration     *Time           `json:"expiration"`
-	Operations     OperationsArray `json:"operations"`
-	Signatures     []string        `json:"signatures"`
+	RefBlockNum    uint16           `json:"ref_block_num"`
+	RefBlockPrefix uint32           `json:"ref_block_prefix"`
+	Expiration     Time             `json:"expiration"`
+	Operations     OperationsArray  `json:"operations"`
+	Extensions     FutureExtensions `json:"extensions"`
+	Signatures     []string         `json:"signatures,omitempty"`
 }
 
 func (tx *Transaction) ID() ([]byte, error) {
-	var b bytes.Buffer
-	encoder := transaction.NewEncoder(&b)
-	if err := tx.MarshalTransaction(encoder); err != nil {
+	b := transaction.AcquireBuffer()
+	defer transaction.ReleaseBuffer(b)
+
+	if err := tx.MarshalTransaction(b.Encoder()); err != nil {
 		return nil, err
 	}
 	h := sha256.Sum256(b.Bytes())
@@ -27,6 +34,7 @@ func (tx *Transaction) ID() ([]byte, error) {
 }
 
 // MarshalTransaction implements transaction.Marshaller interface.
+// It encodes the transaction without the signatures, the form which is signed and which the ID is computed of.
 func (tx *Transaction) MarshalTransaction(encoder *transaction.Encoder) error {
 	if len(tx.Operations) == 0 {
 		return errors.New("no operation specified")
@@ -34,22 +42,114 @@ func (tx *Transaction) MarshalTransaction(encoder *transaction.Encoder) error {
 
 	enc := transaction.NewRollingEncoder(encoder)
 
-	enc.Encode(tx.RefBlockNum)
-	enc.Encode(tx.RefBlockPrefix)
-	enc.Encode(tx.Expiration)
+	enc.EncodeUint16(tx.RefBlockNum)
+	enc.EncodeUint32(tx.RefBlockPrefix)
+	enc.Encode(&tx.Expiration)
 
 	enc.EncodeUVarint(uint64(len(tx.Operations)))
 	for _, op := range tx.Operations {
 		enc.Encode(op)
 	}
 
-	// Extensions are not supported yet.
-	enc.EncodeUVarint(0)
+	if enc.Err() != nil {
+		return enc.Err()
+	}
+	// called directly as the slice passed to Encode would be copied to the heap
+	return tx.Extensions.MarshalTransaction(encoder)
+}
 
-	return enc.Err()
+// UnmarshalTransaction implements transaction.Unmarshaller interface.
+// It decodes the transaction encoded by MarshalTransaction, i.e. without the signatures.
+func (tx *Transaction) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	dec := transaction.NewRollingDecoder(decoder)
+	dec.Decode(&tx.RefBlockNum)
+	dec.Decode(&tx.RefBlockPrefix)
+	dec.Decode(&tx.Expiration)
+
+	n := dec.DecodeLength()
+	if dec.Err() != nil {
+		return dec.Err()
+	}
+
+	tx.Operations = make(OperationsArray, 0, n)
+	for i := 0; i < n; i++ {
+		op, err := decodeOperation(decoder)
+		if err != nil {
+			return err
+		}
+		tx.Operations = append(tx.Operations, op)
+	}
+
+	dec.Decode(&tx.Extensions)
+	return dec.Err()
 }
 
 // PushOperation can be used to add an operation into the transaction.
 func (tx *Transaction) PushOperation(op Operation) {
 	tx.Operations = append(tx.Operations, op)
 }
+
+// MarshalSignedTransaction encodes the transaction followed by the signatures, the way the chain encodes
+// the signed transaction, e.g. in get_transaction_hex and in the blocks.
+func (tx *Transaction) MarshalSignedTransaction(encoder *transaction.Encoder) error {
+	if err := tx.MarshalTransaction(encoder); err != nil {
+		return err
+	}
+
+	enc := transaction.NewRollingEncoder(encoder)
+	enc.EncodeUVarint(uint64(len(tx.Signatures)))
+	for _, signature := range tx.Signatures {
+		sig, err := hex.DecodeString(signature)
+		if err != nil {
+			return fmt.Errorf("decode signature: %w", err)
+		}
+		if len(sig) != signatureLength {
+			return fmt.Errorf("invalid signature length %d", len(sig))
+		}
+		enc.Encode(sig)
+	}
+	return enc.Err()
+}
+
+// UnmarshalSignedTransaction decodes the transaction encoded by MarshalSignedTransaction.
+func (tx *Transaction) UnmarshalSignedTransaction(decoder *transaction.Decoder) error {
+	if err := tx.UnmarshalTransaction(decoder); err != nil {
+		return err
+	}
+
+	n, err := decoder.DecodeLength()
+	if err != nil {
+		return fmt.Errorf("decode signatures: %w", err)
+	}
+
+	tx.Signatures = nil
+	for i := 0; i < n; i++ {
+		sig, err := decoder.DecodeBytes(signatureLength)
+		if err != nil {
+			return fmt.Errorf("decode signature %d: %w", i, err)
+		}
+		tx.Signatures = append(tx.Signatures, hex.EncodeToString(sig))
+	}
+	return nil
+}
+
+// ParseTransactionHex decodes the signed transaction as returned by get_transaction_hex,
+// the whole input must be the transaction.
+func ParseTransactionHex(s string) (*Transaction, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		tx      Transaction
+		decoder = transaction.NewDecoder(bytes.NewReader(b))
+	)
+	if err := tx.UnmarshalSignedTransaction(decoder); err != nil {
+		return nil, err
+	}
+	if err := decoder.ExpectEOF(); err != nil {
+		return nil, err
+	}
+	return &tx, nil
+}
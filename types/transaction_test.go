@@ -19,7 +19,7 @@ func TestTransaction_MarshalTransaction(t *testing.T) {
 	tx := Transaction{
 		RefBlockNum:    36029,
 		RefBlockPrefix: 1164960351,
-		Expiration:     &Time{&expiration},
+		Expiration:     NewTime(expiration),
 	}
 	tx.PushOperation(&VoteOperation{
 		Voter:    "xeroc",
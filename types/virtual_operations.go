@@ -0,0 +1,183 @@
+package types
+
+import (
+	"github.com/google/uuid"
+)
+
+// Rewards are paid either in SCR or in SP depending on the reward fund, the Asset keeps the symbol.
+
+type AuthorRewardOperation struct {
+	Author   string `json:"author"`
+	Permlink string `json:"permlink"`
+	Reward   Asset  `json:"reward"`
+}
+
+func (op *AuthorRewardOperation) Type() OpType {
+	return AuthorReward
+}
+
+type CommentBenefactorRewardOperation struct {
+	Benefactor string `json:"benefactor"`
+	Author     string `json:"author"`
+	Permlink   string `json:"permlink"`
+	Reward     Asset  `json:"reward"`
+}
+
+func (op *CommentBenefactorRewardOperation) Type() OpType {
+	return CommentBenefactorReward
+}
+
+type CommentPayoutUpdateOperation struct {
+	Author   string `json:"author"`
+	Permlink string `json:"permlink"`
+}
+
+func (op *CommentPayoutUpdateOperation) Type() OpType {
+	return CommentPayoutUpdate
+}
+
+type CommentRewardOperation struct {
+	Author              string `json:"author"`
+	Permlink            string `json:"permlink"`
+	FundType            string `json:"fund_type"`
+	Payout              Asset  `json:"payout"`
+	AuthorPayout        Asset  `json:"author_payout"`
+	CuratorsPayout      Asset  `json:"curators_payout"`
+	FromChildrenPayout  Asset  `json:"from_children_payout"`
+	ToParentPayout      Asset  `json:"to_parent_payout"`
+	BeneficiariesPayout Asset  `json:"beneficiaries_payout"`
+}
+
+func (op *CommentRewardOperation) Type() OpType {
+	return CommentReward
+}
+
+type CurationRewardOperation struct {
+	Curator         string `json:"curator"`
+	Reward          Asset  `json:"reward"`
+	CommentAuthor   string `json:"comment_author"`
+	CommentPermlink string `json:"comment_permlink"`
+}
+
+func (op *CurationRewardOperation) Type() OpType {
+	return CurationReward
+}
+
+type FillScorumpowerWithdrawOperation struct {
+	FromAccount string `json:"from_account"`
+	ToAccount   string `json:"to_account"`
+	Withdrawn   Asset  `json:"withdrawn"`
+	Deposited   Asset  `json:"deposited"`
+}
+
+func (op *FillScorumpowerWithdrawOperation) Type() OpType {
+	return FillScorumpowerWithdraw
+}
+
+type HardforkOperation struct {
+	HardforkID uint32 `json:"hardfork_id"`
+}
+
+func (op *HardforkOperation) Type() OpType {
+	return Hardfork
+}
+
+type ReturnScorumpowerDelegationOperation struct {
+	Account     string `json:"account"`
+	Scorumpower Asset  `json:"scorumpower"`
+}
+
+func (op *ReturnScorumpowerDelegationOperation) Type() OpType {
+	return ReturnScorumpowerDelegation
+}
+
+type ShutdownWitnessOperation struct {
+	Owner string `json:"owner"`
+}
+
+func (op *ShutdownWitnessOperation) Type() OpType {
+	return ShutdownWitness
+}
+
+type WitnessMissBlockOperation struct {
+	Owner    string `json:"owner"`
+	BlockNum uint32 `json:"block_num"`
+}
+
+func (op *WitnessMissBlockOperation) Type() OpType {
+	return WitnessMissBlock
+}
+
+type AccFinishedVestingWithdrawOperation struct {
+	Owner string `json:"owner"`
+}
+
+func (op *AccFinishedVestingWithdrawOperation) Type() OpType {
+	return AccFinishedVestingWithdraw
+}
+
+type DevpoolFinishedVestingWithdrawOperation struct{}
+
+func (op *DevpoolFinishedVestingWithdrawOperation) Type() OpType {
+	return DevpoolFinishedVestingWithdraw
+}
+
+type AccToAccVestingWithdrawOperation struct {
+	FromAccount string `json:"from_account"`
+	ToAccount   string `json:"to_account"`
+	Withdrawn   Asset  `json:"withdrawn"`
+}
+
+func (op *AccToAccVestingWithdrawOperation) Type() OpType {
+	return AccToAccVestingWithdraw
+}
+
+type DevpoolToAccVestingWithdrawOperation struct {
+	ToAccount string `json:"to_account"`
+	Withdrawn Asset  `json:"withdrawn"`
+}
+
+func (op *DevpoolToAccVestingWithdrawOperation) Type() OpType {
+	return DevpoolToAccVestingWithdraw
+}
+
+type AccToDevpoolVestingWithdrawOperation struct {
+	FromAccount string `json:"from_account"`
+	Withdrawn   Asset  `json:"withdrawn"`
+}
+
+func (op *AccToDevpoolVestingWithdrawOperation) Type() OpType {
+	return AccToDevpoolVestingWithdraw
+}
+
+type DevpoolToDevpoolVestingWithdrawOperation struct {
+	Withdrawn Asset `json:"withdrawn"`
+}
+
+func (op *DevpoolToDevpoolVestingWithdrawOperation) Type() OpType {
+	return DevpoolToDevpoolVesting
+}
+
+type BetRestoredOperation struct {
+	GameUUID uuid.UUID `json:"game_uuid"`
+	Better   string    `json:"better"`
+	BetUUID  uuid.UUID `json:"bet_uuid"`
+	Stake    Asset     `json:"stake"`
+}
+
+func (op *BetRestoredOperation) Type() OpType {
+	return BetRestored
+}
+
+type BetUpdatedOperation struct {
+	GameUUID uuid.UUID     `json:"game_uuid"`
+	Better   string        `json:"better"`
+	BetUUID  uuid.UUID     `json:"bet_uuid"`
+	Kind     BetCancelKind `json:"kind"`
+	OldStake Asset         `json:"old_stake"`
+	NewStake Asset         `json:"new_stake"`
+}
+
+func (op *BetUpdatedOperation) Type() OpType {
+	return BetUpdated
+}
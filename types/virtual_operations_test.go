@@ -0,0 +1,125 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVirtualOperations_UnmarshalJSON(t *testing.T) {
+	gameUUID := uuid.MustParse("e629f9aa-6b2c-46aa-8fa8-36770e7a7a5f")
+	betUUID := uuid.MustParse("a9a0fcb6-b9b5-4e7c-8bd8-3d2b8b3e6e0a")
+
+	fixtures := []struct {
+		json     string
+		expected Operation
+	}{
+		{
+			json:     `["author_reward",{"author":"alice","permlink":"post","reward":"1.000000000 SP"}]`,
+			expected: &AuthorRewardOperation{Author: "alice", Permlink: "post", Reward: mustAsset(t, "1.000000000 SP")},
+		},
+		{
+			json:     `["comment_benefactor_reward",{"benefactor":"bob","author":"alice","permlink":"post","reward":"0.100000000 SCR"}]`,
+			expected: &CommentBenefactorRewardOperation{Benefactor: "bob", Author: "alice", Permlink: "post", Reward: mustAsset(t, "0.100000000 SCR")},
+		},
+		{
+			json:     `["comment_payout_update",{"author":"alice","permlink":"post"}]`,
+			expected: &CommentPayoutUpdateOperation{Author: "alice", Permlink: "post"},
+		},
+		{
+			json: `["comment_reward",{"author":"alice","permlink":"post","fund_type":"0.000000000 SP","payout":"2.000000000 SP","author_payout":"1.500000000 SP",` +
+				`"curators_payout":"0.500000000 SP","from_children_payout":"0.000000000 SP","to_parent_payout":"0.000000000 SP","beneficiaries_payout":"0.000000000 SP"}]`,
+			expected: &CommentRewardOperation{
+				Author:              "alice",
+				Permlink:            "post",
+				FundType:            "0.000000000 SP",
+				Payout:              mustAsset(t, "2.000000000 SP"),
+				AuthorPayout:        mustAsset(t, "1.500000000 SP"),
+				CuratorsPayout:      mustAsset(t, "0.500000000 SP"),
+				FromChildrenPayout:  mustAsset(t, "0.000000000 SP"),
+				ToParentPayout:      mustAsset(t, "0.000000000 SP"),
+				BeneficiariesPayout: mustAsset(t, "0.000000000 SP"),
+			},
+		},
+		{
+			json:     `["curation_reward",{"curator":"bob","reward":"0.500000000 SP","comment_author":"alice","comment_permlink":"post"}]`,
+			expected: &CurationRewardOperation{Curator: "bob", Reward: mustAsset(t, "0.500000000 SP"), CommentAuthor: "alice", CommentPermlink: "post"},
+		},
+		{
+			json:     `["fill_scorumpower_withdraw",{"from_account":"alice","to_account":"bob","withdrawn":"1.000000000 SP","deposited":"1.000000000 SCR"}]`,
+			expected: &FillScorumpowerWithdrawOperation{FromAccount: "alice", ToAccount: "bob", Withdrawn: mustAsset(t, "1.000000000 SP"), Deposited: mustAsset(t, "1.000000000 SCR")},
+		},
+		{
+			json:     `["hardfork",{"hardfork_id":4}]`,
+			expected: &HardforkOperation{HardforkID: 4},
+		},
+		{
+			json:     `["return_scorumpower_delegation",{"account":"alice","scorumpower":"5.000000000 SP"}]`,
+			expected: &ReturnScorumpowerDelegationOperation{Account: "alice", Scorumpower: mustAsset(t, "5.000000000 SP")},
+		},
+		{
+			json:     `["shutdown_witness",{"owner":"witness1"}]`,
+			expected: &ShutdownWitnessOperation{Owner: "witness1"},
+		},
+		{
+			json:     `["witness_miss_block",{"owner":"witness1","block_num":100500}]`,
+			expected: &WitnessMissBlockOperation{Owner: "witness1", BlockNum: 100500},
+		},
+		{
+			json:     `["acc_finished_vesting_withdraw",{"owner":"alice"}]`,
+			expected: &AccFinishedVestingWithdrawOperation{Owner: "alice"},
+		},
+		{
+			json:     `["devpool_finished_vesting_withdraw",{}]`,
+			expected: &DevpoolFinishedVestingWithdrawOperation{},
+		},
+		{
+			json:     `["acc_to_acc_vesting_withdraw",{"from_account":"alice","to_account":"bob","withdrawn":"1.000000000 SP"}]`,
+			expected: &AccToAccVestingWithdrawOperation{FromAccount: "alice", ToAccount: "bob", Withdrawn: mustAsset(t, "1.000000000 SP")},
+		},
+		{
+			json:     `["devpool_to_acc_vesting_withdraw",{"to_account":"bob","withdrawn":"1.000000000 SP"}]`,
+			expected: &DevpoolToAccVestingWithdrawOperation{ToAccount: "bob", Withdrawn: mustAsset(t, "1.000000000 SP")},
+		},
+		{
+			json:     `["acc_to_devpool_vesting_withdraw",{"from_account":"alice","withdrawn":"1.000000000 SP"}]`,
+			expected: &AccToDevpoolVestingWithdrawOperation{FromAccount: "alice", Withdrawn: mustAsset(t, "1.000000000 SP")},
+		},
+		{
+			json:     `["devpool_to_devpool_vesting_withdraw",{"withdrawn":"1.000000000 SP"}]`,
+			expected: &DevpoolToDevpoolVestingWithdrawOperation{Withdrawn: mustAsset(t, "1.000000000 SP")},
+		},
+		{
+			json: `["bet_restored",{"game_uuid":"e629f9aa-6b2c-46aa-8fa8-36770e7a7a5f","better":"alice",` +
+				`"bet_uuid":"a9a0fcb6-b9b5-4e7c-8bd8-3d2b8b3e6e0a","stake":"1.000000000 SCR"}]`,
+			expected: &BetRestoredOperation{GameUUID: gameUUID, Better: "alice", BetUUID: betUUID, Stake: *AssetFromFloat(1)},
+		},
+		{
+			json: `["bet_updated",{"game_uuid":"e629f9aa-6b2c-46aa-8fa8-36770e7a7a5f","better":"alice",` +
+				`"bet_uuid":"a9a0fcb6-b9b5-4e7c-8bd8-3d2b8b3e6e0a","kind":"pending","old_stake":"1.000000000 SCR","new_stake":"2.000000000 SCR"}]`,
+			expected: &BetUpdatedOperation{
+				GameUUID: gameUUID,
+				Better:   "alice",
+				BetUUID:  betUUID,
+				Kind:     PendingBetKind,
+				OldStake: *AssetFromFloat(1),
+				NewStake: *AssetFromFloat(2),
+			},
+		},
+	}
+
+	for _, fixture := range fixtures {
+		t.Run(string(fixture.expected.Type()), func(t *testing.T) {
+			var ops OperationsArray
+			require.NoError(t, json.Unmarshal([]byte("["+fixture.json+"]"), &ops))
+			require.Len(t, ops, 1)
+			require.Equal(t, fixture.expected, ops[0])
+		})
+	}
+}
+
+func TestAuthorRewardOpType(t *testing.T) {
+	require.Equal(t, CommentBenefactorReward.Code()-1, AuthorReward.Code())
+}
@@ -3,7 +3,9 @@ package types
 import (
 	"encoding/json"
 	"errors"
-	"github.com/bitly/go-simplejson"
+	"fmt"
+	"math"
+
 	"github.com/scorum/scorum-go/encoding/transaction"
 )
 
@@ -82,70 +84,110 @@ type WincaseInterface interface {
 }
 
 func (w Wincase) MarshalJSON() ([]byte, error) {
+	if w.WincaseInterface == nil {
+		return []byte("null"), nil
+	}
 	return json.Marshal(w.WincaseInterface)
 }
 
 func (w *Wincase) UnmarshalJSON(b []byte) error {
-	json, err := simplejson.NewJson(b)
+	if string(b) == "null" {
+		w.WincaseInterface = nil
+		return nil
+	}
+
+	name, _, err := unmarshalNamedTuple(b)
 	if err != nil {
 		return err
 	}
 
-	wincaseName, err := json.GetIndex(0).String()
+	id, ok := wincaseIDByName(name)
+	if !ok {
+		return fmt.Errorf("%w: %s", errUnknownWincase, name)
+	}
+
+	wincase, err := newWincase(id)
 	if err != nil {
 		return err
 	}
 
-	wincaseID := WincaseID(-1)
-	for k, v := range WincaseNames {
-		if v == wincaseName {
-			wincaseID = k
-		}
+	if err := json.Unmarshal(b, wincase); err != nil {
+		return err
 	}
-	if wincaseID == -1 {
+	w.WincaseInterface = wincase
+	return nil
+}
+
+// UnmarshalTransaction decodes the wincase static variant, the wincase fields depend on the wincase id.
+func (w *Wincase) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	id, err := decoder.PeekUVarint()
+	if err != nil {
+		return err
+	}
+	if id > math.MaxInt8 {
 		return errUnknownWincase
 	}
 
-	wincaseObj := json.GetIndex(1)
+	wincase, err := newWincase(WincaseID(id))
+	if err != nil {
+		return err
+	}
 
-	threshold, err := wincaseObj.Get("threshold").Int()
-	if err == nil {
-		wincase := OverUnderWincase{
-			ID:        wincaseID,
-			Threshold: int16(threshold),
-		}
-		w.WincaseInterface = WincaseInterface(&wincase)
-		return nil
+	if err := decoder.Decode(wincase); err != nil {
+		return err
+	}
+	w.WincaseInterface = wincase
+	return nil
+}
+
+// newWincase creates the empty wincase of the type the id belongs to.
+func newWincase(id WincaseID) (WincaseInterface, error) {
+	switch id {
+	case WincaseHandicapOver, WincaseHandicapUnder, WincaseTotalOver, WincaseTotalUnder:
+		return &OverUnderWincase{ID: id}, nil
+	case WincaseCorrectScoreYes, WincaseCorrectScoreNo:
+		return &ScoreYesNoWincase{ID: id}, nil
 	}
 
-	home, err := wincaseObj.Get("home").Int()
-	if err == nil {
-		away, err := wincaseObj.Get("away").Int()
-		if err == nil {
-			wincase := ScoreYesNoWincase{
-				ID:   wincaseID,
-				Home: uint16(home),
-				Away: uint16(away),
-			}
-			w.WincaseInterface = WincaseInterface(&wincase)
-			return nil
+	if _, ok := WincaseNames[id]; !ok {
+		return nil, errUnknownWincase
+	}
+	return &YesNoWincase{ID: id}, nil
+}
+
+func wincaseIDByName(name string) (WincaseID, bool) {
+	for id, v := range WincaseNames {
+		if v == name {
+			return id, true
 		}
 	}
+	return 0, false
+}
+
+// unmarshalWincase decodes the wincase given as [name, meta] and returns the wincase id.
+func unmarshalWincase(b []byte, meta interface{}) (WincaseID, error) {
+	name, value, err := unmarshalNamedTuple(b)
+	if err != nil {
+		return 0, err
+	}
 
-	wincase := YesNoWincase{
-		ID: wincaseID,
+	id, ok := wincaseIDByName(name)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", errUnknownWincase, name)
 	}
-	w.WincaseInterface = WincaseInterface(&wincase)
 
-	return nil
+	if err := json.Unmarshal(value, meta); err != nil {
+		return 0, err
+	}
+	return id, nil
 }
 
 type WincaseID int8
 
 type OverUnderWincase struct {
-	ID WincaseID
+	ID WincaseID `scorum:"0"`
 
-	Threshold int16
+	Threshold int16 `scorum:"1"`
 }
 
 func (w OverUnderWincase) MarshalJSON() ([]byte, error) {
@@ -165,11 +207,25 @@ func (w OverUnderWincase) MarshalJSON() ([]byte, error) {
 	return json.Marshal(a)
 }
 
+func (op *OverUnderWincase) UnmarshalJSON(b []byte) error {
+	var meta struct {
+		Threshold int16 `json:"threshold"`
+	}
+	id, err := unmarshalWincase(b, &meta)
+	if err != nil {
+		return err
+	}
+
+	*op = OverUnderWincase{ID: id, Threshold: meta.Threshold}
+	return nil
+}
+
 func (op *OverUnderWincase) MarshalTransaction(encoder *transaction.Encoder) error {
-	enc := transaction.NewRollingEncoder(encoder)
-	enc.Encode(int8(op.ID))
-	enc.Encode(op.Threshold)
-	return enc.Err()
+	return encoder.EncodeStruct(op)
+}
+
+func (op *OverUnderWincase) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decoder.DecodeStruct(op)
 }
 
 func (op *OverUnderWincase) GetName() string {
@@ -191,10 +247,10 @@ func (op *OverUnderWincase) GetMeta() (json.RawMessage, error) {
 }
 
 type ScoreYesNoWincase struct {
-	ID WincaseID
+	ID WincaseID `scorum:"0"`
 
-	Home uint16
-	Away uint16
+	Home uint16 `scorum:"1"`
+	Away uint16 `scorum:"2"`
 }
 
 func (w ScoreYesNoWincase) MarshalJSON() ([]byte, error) {
@@ -214,12 +270,26 @@ func (w ScoreYesNoWincase) MarshalJSON() ([]byte, error) {
 	return json.Marshal(a)
 }
 
+func (op *ScoreYesNoWincase) UnmarshalJSON(b []byte) error {
+	var meta struct {
+		Home uint16 `json:"home"`
+		Away uint16 `json:"away"`
+	}
+	id, err := unmarshalWincase(b, &meta)
+	if err != nil {
+		return err
+	}
+
+	*op = ScoreYesNoWincase{ID: id, Home: meta.Home, Away: meta.Away}
+	return nil
+}
+
 func (op *ScoreYesNoWincase) MarshalTransaction(encoder *transaction.Encoder) error {
-	enc := transaction.NewRollingEncoder(encoder)
-	enc.Encode(int8(op.ID))
-	enc.Encode(op.Home)
-	enc.Encode(op.Away)
-	return enc.Err()
+	return encoder.EncodeStruct(op)
+}
+
+func (op *ScoreYesNoWincase) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decoder.DecodeStruct(op)
 }
 
 func (op *ScoreYesNoWincase) GetName() string {
@@ -243,7 +313,7 @@ func (op *ScoreYesNoWincase) GetMeta() (json.RawMessage, error) {
 }
 
 type YesNoWincase struct {
-	ID WincaseID
+	ID WincaseID `scorum:"0"`
 }
 
 func (w YesNoWincase) MarshalJSON() ([]byte, error) {
@@ -262,10 +332,23 @@ func (w YesNoWincase) MarshalJSON() ([]byte, error) {
 	return json.Marshal(a)
 }
 
+func (op *YesNoWincase) UnmarshalJSON(b []byte) error {
+	var meta struct{}
+	id, err := unmarshalWincase(b, &meta)
+	if err != nil {
+		return err
+	}
+
+	*op = YesNoWincase{ID: id}
+	return nil
+}
+
 func (op *YesNoWincase) MarshalTransaction(encoder *transaction.Encoder) error {
-	enc := transaction.NewRollingEncoder(encoder)
-	enc.Encode(int8(op.ID))
-	return enc.Err()
+	return encoder.EncodeStruct(op)
+}
+
+func (op *YesNoWincase) UnmarshalTransaction(decoder *transaction.Decoder) error {
+	return decoder.DecodeStruct(op)
 }
 
 func (op *YesNoWincase) GetName() string {
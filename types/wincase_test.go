@@ -101,3 +101,28 @@ func TestYesNoWincase_GetMeta(t *testing.T) {
 	require.NoError(t, err)
 	require.EqualValues(t, testYesNoMeta, meta)
 }
+
+func TestWincase_JSON(t *testing.T) {
+	for id, name := range WincaseNames {
+		wincase, err := newWincase(id)
+		require.NoError(t, err)
+
+		// the zero meta must be resolved by the wincase id rather than by the fields given
+		data, err := json.Marshal(Wincase{wincase})
+		require.NoError(t, err, name)
+
+		var decoded Wincase
+		require.NoError(t, json.Unmarshal(data, &decoded), name)
+		require.Equal(t, Wincase{wincase}, decoded, name)
+	}
+
+	var wincase Wincase
+	require.NoError(t, json.Unmarshal([]byte(`null`), &wincase))
+	require.Nil(t, wincase.WincaseInterface)
+
+	data, err := json.Marshal(wincase)
+	require.NoError(t, err)
+	require.Equal(t, `null`, string(data))
+
+	require.Error(t, json.Unmarshal([]byte(`["correct_score::yes",{"home":-1,"away":2}]`), &wincase))
+}
@@ -0,0 +1,64 @@
+package scorumgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/scorum/scorum-go/key"
+	"github.com/scorum/scorum-go/types"
+)
+
+var (
+	ErrNotWitness             = errors.New("account is not a witness")
+	ErrSigningKeyNotConfirmed = errors.New("witness signing key is not updated")
+)
+
+// RotateWitnessSigningKey broadcasts witness_update setting signingKey as the block signing key of the owner witness,
+// it is signed with the owner active key and keeps the witness url and props, then confirms the key is changed on-chain.
+// The caller generates the new key and stores its private part before the rotation: the node may accept the update
+// even when an error is returned, e.g. on a broadcast timeout, and the private key must be put into the witness node
+// config before the witness is scheduled to produce a block.
+func (client *Client) RotateWitnessSigningKey(ctx context.Context, chainID []byte, owner string, signingKey *key.PublicKey, activeKey *key.PrivateKey) error {
+	if signingKey == nil {
+		return errors.New("signing key is required")
+	}
+
+	witness, err := client.Database.GetWitnessByAccount(ctx, owner)
+	if err != nil {
+		return fmt.Errorf("get witness by account: %w", err)
+	}
+
+	if witness == nil {
+		return ErrNotWitness
+	}
+
+	signingPub := types.PublicKey(signingKey.String())
+
+	ops := []types.Operation{
+		&types.WitnessUpdateOperation{
+			Owner:           owner,
+			Url:             witness.Url,
+			BlockSigningKey: signingPub,
+			Props: types.WitnessUpdateOperationProps{
+				AccountCreationFee: witness.Props.AccountCreationFee,
+				MaximumBlockSize:   witness.Props.MaximumBlockSize,
+			},
+		},
+	}
+
+	if _, err := client.BroadcastTransactionSynchronous(ctx, chainID, ops, activeKey); err != nil {
+		return fmt.Errorf("broadcast witness update: %w", err)
+	}
+
+	witness, err = client.Database.GetWitnessByAccount(ctx, owner)
+	if err != nil {
+		return fmt.Errorf("get witness by account: %w", err)
+	}
+
+	if witness == nil || witness.SigningKey != signingPub {
+		return ErrSigningKeyNotConfirmed
+	}
+
+	return nil
+}